@@ -2,6 +2,8 @@ package application
 
 import (
 	"flag"
+	"fmt"
+	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -15,12 +17,84 @@ type Config struct {
 	SERVER_PORT              string
 	SERVER_ENABLE_CORS       bool
 	SERVER_ENABLE_PLAYGROUND bool
+	SERVER_ADMIN_TOKEN       string
 	DATA_GTFS                string
+	DATA_FEEDS               []FeedConfig
+	DATA_FILTER              FilterConfig
 	DATA_DIRECTIONS          string
+	DATA_SNAPSHOT            string
+	DATA_WATCH               bool
 	OSRM_ENDPOINT            string
+	OCTRANSPO_AGENCY         string
+	OCTRANSPO_CACHE_SIZE     int
+	GTFSRT_TRIP_UPDATES      string
+	GTFSRT_VEHICLE_POSITIONS string
+	GTFSRT_ALERTS            string
+	GTFSRT_PREFIX            string
+	GTFSRT_INTERVAL          time.Duration
+}
+
+// FeedConfig is a GTFS feed in the [[data.feeds]] tables
+type FeedConfig struct {
+	Prefix string `mapstructure:"prefix"`
+	Agency string `mapstructure:"agency"`
+	Path   string `mapstructure:"path"`
+}
+
+// FilterConfig selects the parts of the feeds to load from the [data.filter] table
+type FilterConfig struct {
+	Start    time.Time // zero defaults to today
+	End      time.Time // zero has no limit
+	Routes   []string
+	Agencies []string
+}
+
+type filterConfigData struct {
+	Start    string   `mapstructure:"start"`
+	End      string   `mapstructure:"end"`
+	Routes   []string `mapstructure:"routes"`
+	Agencies []string `mapstructure:"agencies"`
+}
+
+func getFilterConfig() FilterConfig {
+	data := filterConfigData{}
+	if err := viper.UnmarshalKey("data.filter", &data); err != nil {
+		panic(err)
+	}
+
+	date := func(key, value string) time.Time {
+		if value == "" {
+			return time.Time{}
+		}
+		t, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			panic(fmt.Errorf("invalid data.filter.%s %q, expected YYYY-MM-DD", key, value))
+		}
+		return t
+	}
+
+	return FilterConfig{
+		Start:    date("start", data.Start),
+		End:      date("end", data.End),
+		Routes:   data.Routes,
+		Agencies: data.Agencies,
+	}
 }
 
 func GetConfig() Config {
+	feeds := []FeedConfig{}
+	if err := viper.UnmarshalKey("data.feeds", &feeds); err != nil {
+		panic(err)
+	}
+
+	// a single feed from data.gtfs when no feeds are configured
+	if len(feeds) == 0 {
+		feeds = append(feeds, FeedConfig{
+			Agency: viper.GetString("octranspo.agency"),
+			Path:   viper.GetString("data.gtfs"),
+		})
+	}
+
 	return Config{
 		OCTRANSPO_ENDPOINT:       viper.GetString("octranspo.endpoint"),
 		OCTRANSPO_APP_ID:         viper.GetString("octranspo.app_id"),
@@ -29,9 +103,21 @@ func GetConfig() Config {
 		SERVER_PORT:              viper.GetString("server.port"),
 		SERVER_ENABLE_CORS:       viper.GetBool("server.cors"),
 		SERVER_ENABLE_PLAYGROUND: viper.GetBool("server.playground"),
+		SERVER_ADMIN_TOKEN:       viper.GetString("server.admin_token"),
 		DATA_GTFS:                viper.GetString("data.gtfs"),
+		DATA_FEEDS:               feeds,
+		DATA_FILTER:              getFilterConfig(),
 		DATA_DIRECTIONS:          viper.GetString("data.directions"),
+		DATA_SNAPSHOT:            viper.GetString("data.snapshot"),
+		DATA_WATCH:               viper.GetBool("data.watch"),
 		OSRM_ENDPOINT:            viper.GetString("osrm.endpoint"),
+		OCTRANSPO_AGENCY:         viper.GetString("octranspo.agency"),
+		OCTRANSPO_CACHE_SIZE:     viper.GetInt("octranspo.cache_size"),
+		GTFSRT_TRIP_UPDATES:      viper.GetString("gtfsrt.trip_updates"),
+		GTFSRT_VEHICLE_POSITIONS: viper.GetString("gtfsrt.vehicle_positions"),
+		GTFSRT_ALERTS:            viper.GetString("gtfsrt.alerts"),
+		GTFSRT_PREFIX:            viper.GetString("gtfsrt.prefix"),
+		GTFSRT_INTERVAL:          time.Duration(viper.GetInt("gtfsrt.interval")) * time.Second,
 	}
 }
 
@@ -46,6 +132,9 @@ func ReadConfig() {
 	config := viper.GetString("config")
 	viper.SetConfigName(config)
 	viper.SetConfigType("toml")
+	viper.SetDefault("octranspo.agency", "OC Transpo")
+	viper.SetDefault("octranspo.cache_size", 1000)
+	viper.SetDefault("gtfsrt.interval", 30)
 	viper.AddConfigPath("./")
 
 	if err := viper.ReadInConfig(); err != nil {
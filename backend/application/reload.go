@@ -0,0 +1,175 @@
+package application
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	fp "path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrReloading = errors.New("a reload is already in progress")
+
+// time without changes to the feeds before reloading. copying a feed creates many events
+const reloadDebounce = time.Second * 10
+
+/* EnableReload
+allows the server to reload its data without restarting. build creates the dependencies
+from the latest data, it runs in the background while the old dependencies keep serving requests.
+both DBs are in memory until the swap so a reload needs about twice the memory of the data
+*/
+func (s *Server) EnableReload(build func() (*ServerDependencies, error)) {
+	s.build = build
+}
+
+/* Reload
+builds new dependencies and swaps the GraphQL handler. requests in progress finish on the
+old data. the old data stays in use when building fails
+*/
+func (s *Server) Reload() (err error) {
+	if s.build == nil {
+		return errors.New("reloading is not enabled")
+	}
+
+	if !s.reloading.TryLock() {
+		return ErrReloading
+	}
+	defer s.reloading.Unlock()
+
+	// feed errors are returned by build, indexes can still panic on data they don't expect
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to build the dependencies: %v", r)
+		}
+	}()
+
+	t0 := time.Now()
+
+	deps, err := s.build()
+	if err != nil {
+		return err
+	}
+	s.swap(deps)
+
+	// release the old data once requests using it are done
+	runtime.GC()
+
+	log.Info().Dur("duration", time.Since(t0)).Msg("reloaded the data")
+	return nil
+}
+
+// reload in the background and log the result
+func (s *Server) reloadAsync() {
+	go func() {
+		if err := s.Reload(); err != nil {
+			log.Error().Err(err).Msg("failed to reload the data")
+		}
+	}()
+}
+
+/* ReloadHandler
+POST /admin/reload with the header "Authorization: Bearer <admin token>".
+responds 202 once the reload starts in the background, or 409 if one is already in progress
+*/
+func (s *Server) ReloadHandler(w http.ResponseWriter, r *http.Request) {
+	authorization := r.Header.Get("Authorization")
+	token := strings.TrimPrefix(authorization, "Bearer ")
+	if token == authorization || subtle.ConstantTimeCompare([]byte(token), []byte(s.config.AdminToken)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if !s.reloading.TryLock() {
+		http.Error(w, ErrReloading.Error(), http.StatusConflict)
+		return
+	}
+	s.reloading.Unlock()
+
+	s.reloadAsync()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+/* Watch
+reloads the data when the files at the paths change. directories are watched for changes to
+the files they contain, archives are watched through their parent directory so replacing the
+file is detected
+*/
+func (s *Server) Watch(paths []string) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	watched := map[string]bool{}
+	for _, path := range paths {
+		path = fp.Clean(path)
+		info, err := os.Stat(path)
+		if err != nil {
+			watcher.Close()
+			return err
+		}
+
+		dir := path
+		if !info.IsDir() {
+			dir = fp.Dir(path)
+		}
+
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return err
+		}
+		watched[path] = true
+	}
+
+	// events for files in the watched directories that are not feeds are ignored
+	relevant := func(name string) bool {
+		name = fp.Clean(name)
+		return watched[name] || watched[fp.Dir(name)]
+	}
+
+	go func() {
+		defer watcher.Close()
+
+		timer := time.NewTimer(s.debounce)
+		timer.Stop()
+
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if relevant(event.Name) {
+					timer.Reset(s.debounce)
+				}
+
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Error().Err(err).Msg("failed to watch the feeds")
+
+			case <-timer.C:
+				// events during the reload are handled once it is done
+				log.Info().Msg("feeds changed, reloading the data")
+				err := s.Reload()
+				if errors.Is(err, ErrReloading) {
+					// the reload in progress may have read the feeds before they changed
+					timer.Reset(s.debounce)
+				} else if err != nil {
+					log.Error().Err(err).Msg("failed to reload the data")
+				}
+			}
+		}
+	}()
+
+	log.Info().Strs("paths", paths).Msg("watching the feeds for changes")
+	return nil
+}
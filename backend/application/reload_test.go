@@ -0,0 +1,140 @@
+package application
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	fp "path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+// every stop has the same name
+type testStops string
+
+func (s testStops) Get(stopId string) (model.Stop, error) {
+	return model.Stop{Id: stopId, Name: string(s)}, nil
+}
+
+func testDependencies(name string) *ServerDependencies {
+	return &ServerDependencies{Stops: testStops(name), Location: time.UTC}
+}
+
+// name of the stop served by the GraphQL handler
+func stopName(t *testing.T, s *Server) string {
+	request := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query": "{ stop(id: \"A\") { name } }"}`))
+	request.Header.Set("Content-Type", "application/json")
+	response := httptest.NewRecorder()
+
+	s.handler.Load().(http.Handler).ServeHTTP(response, request)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	body := response.Body.String()
+	start := strings.Index(body, `"name":"`) + len(`"name":"`)
+	return body[start : start+strings.Index(body[start:], `"`)]
+}
+
+func TestReload(t *testing.T) {
+	server := NewServer(&ServerConfig{}, testDependencies("old"))
+	assert.Error(t, server.Reload(), "reloading is not enabled")
+
+	build := func() (*ServerDependencies, error) { return testDependencies("new"), nil }
+	server.EnableReload(func() (*ServerDependencies, error) { return build() })
+	assert.Equal(t, "old", stopName(t, server))
+
+	// failed builds keep the old data
+	build = func() (*ServerDependencies, error) { return nil, errors.New("invalid feed") }
+	assert.EqualError(t, server.Reload(), "invalid feed")
+	assert.Equal(t, "old", stopName(t, server))
+
+	build = func() (*ServerDependencies, error) { panic("index out of range") }
+	assert.EqualError(t, server.Reload(), "failed to build the dependencies: index out of range")
+	assert.Equal(t, "old", stopName(t, server))
+
+	build = func() (*ServerDependencies, error) { return testDependencies("new"), nil }
+	assert.NoError(t, server.Reload())
+	assert.Equal(t, "new", stopName(t, server))
+}
+
+func TestReloadConcurrent(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+
+	server := NewServer(&ServerConfig{AdminToken: "secret"}, testDependencies("old"))
+	server.EnableReload(func() (*ServerDependencies, error) {
+		close(started)
+		<-release
+		return testDependencies("new"), nil
+	})
+
+	done := make(chan error)
+	go func() { done <- server.Reload() }()
+	<-started
+
+	// a reload is already in progress
+	assert.ErrorIs(t, server.Reload(), ErrReloading)
+	assert.Equal(t, http.StatusConflict, reload(server, "Bearer secret"))
+
+	close(release)
+	assert.NoError(t, <-done)
+	assert.Equal(t, "new", stopName(t, server))
+}
+
+// status of POST /admin/reload with the authorization header
+func reload(s *Server, authorization string) int {
+	request := httptest.NewRequest(http.MethodPost, "/admin/reload", nil)
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	response := httptest.NewRecorder()
+	s.ReloadHandler(response, request)
+	return response.Code
+}
+
+func TestReloadHandler(t *testing.T) {
+	reloaded := make(chan struct{})
+
+	server := NewServer(&ServerConfig{AdminToken: "secret"}, testDependencies("old"))
+	server.EnableReload(func() (*ServerDependencies, error) {
+		defer close(reloaded)
+		return testDependencies("new"), nil
+	})
+
+	assert.Equal(t, http.StatusUnauthorized, reload(server, ""))
+	assert.Equal(t, http.StatusUnauthorized, reload(server, "Bearer wrong"))
+	assert.Equal(t, http.StatusUnauthorized, reload(server, "secret"))
+	assert.Equal(t, "old", stopName(t, server))
+
+	assert.Equal(t, http.StatusAccepted, reload(server, "Bearer secret"))
+	<-reloaded
+	assert.Eventually(t, func() bool { return stopName(t, server) == "new" }, time.Second, 10*time.Millisecond)
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	builds := int64(0)
+
+	server := NewServer(&ServerConfig{}, testDependencies("old"))
+	server.debounce = 200 * time.Millisecond
+	server.EnableReload(func() (*ServerDependencies, error) {
+		atomic.AddInt64(&builds, 1)
+		return testDependencies("new"), nil
+	})
+	assert.NoError(t, server.Watch([]string{dir}))
+
+	// copying a feed writes every file
+	for _, name := range []string{"agency.txt", "routes.txt", "trips.txt", "stop_times.txt", "stops.txt"} {
+		assert.NoError(t, os.WriteFile(fp.Join(dir, name), []byte("id\n"), 0644))
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	assert.Eventually(t, func() bool { return atomic.LoadInt64(&builds) == 1 }, 2*time.Second, 10*time.Millisecond)
+	time.Sleep(400 * time.Millisecond)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&builds))
+	assert.Equal(t, "new", stopName(t, server))
+}
@@ -0,0 +1,43 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"stop-checker.com/db/model"
+)
+
+type AlertResolvers struct {
+}
+
+func (r *AlertResolvers) Active(ctx context.Context, obj *model.Alert) (bool, error) {
+	return obj.Active(time.Now()), nil
+}
+
+type AlertPeriodResolvers struct {
+}
+
+func (r *AlertPeriodResolvers) Start(ctx context.Context, obj *model.AlertPeriod) (*time.Time, error) {
+	if obj.Start.IsZero() {
+		return nil, nil
+	}
+	return &obj.Start, nil
+}
+
+func (r *AlertPeriodResolvers) End(ctx context.Context, obj *model.AlertPeriod) (*time.Time, error) {
+	if obj.End.IsZero() {
+		return nil, nil
+	}
+	return &obj.End, nil
+}
+
+// alerts active from start to end. a zero end includes every alert that has not ended
+func alertsDuring(alerts []model.Alert, start, end time.Time) []model.Alert {
+	results := []model.Alert{}
+	for _, alert := range alerts {
+		if alert.During(start, end) {
+			results = append(results, alert)
+		}
+	}
+	return results
+}
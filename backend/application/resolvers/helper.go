@@ -1,6 +1,9 @@
 package resolvers
 
-import "stop-checker.com/application/schema"
+import (
+	"stop-checker.com/application/schema"
+	"stop-checker.com/db/model"
+)
 
 func refList[T any](t []T) []*T {
 	results := make([]*T, len(t))
@@ -30,6 +33,21 @@ func nullable[T any](t T, err error) *T {
 	return &t
 }
 
+func accessibility(a model.Accessibility) schema.Accessibility {
+	switch a {
+	case model.Accessible:
+		return schema.AccessibilityAccessible
+	case model.NotAccessible:
+		return schema.AccessibilityNotAccessible
+	}
+	return schema.AccessibilityUnknown
+}
+
+// stop codes and route names from other agencies would match the wrong OC Transpo stops
+func hasLiveData(stop model.Stop, ocTranspoAgency string) bool {
+	return stop.Agency == ocTranspoAgency
+}
+
 type Page[T any] struct {
 	schema.PageInput
 	data []T
@@ -0,0 +1,29 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"stop-checker.com/db/model"
+)
+
+type PredictionResolvers struct {
+}
+
+func (r *PredictionResolvers) Arrival(ctx context.Context, obj *model.Prediction) (*time.Time, error) {
+	if !obj.Predicted {
+		return nil, nil
+	}
+	return &obj.Arrival, nil
+}
+
+func (r *PredictionResolvers) Departure(ctx context.Context, obj *model.Prediction) (*time.Time, error) {
+	if !obj.Predicted {
+		return nil, nil
+	}
+	return &obj.Departure, nil
+}
+
+func (r *PredictionResolvers) Delay(ctx context.Context, obj *model.Prediction) (int, error) {
+	return int(obj.Delay.Round(time.Minute).Minutes()), nil
+}
@@ -9,6 +9,7 @@ import (
 	"stop-checker.com/application/services"
 	"stop-checker.com/db/model"
 	"stop-checker.com/db/repository"
+	"stop-checker.com/features/travel"
 )
 
 type QueryResolver struct {
@@ -17,6 +18,7 @@ type QueryResolver struct {
 	repository.StopLocationSearch
 	repository.StopTextSearch
 	*QueryTravelPlanner
+	ServiceAlerts services.Alerts // nil without a GTFS-Realtime feed
 }
 
 func (r *QueryResolver) Stop(ctx context.Context, id string) (*model.Stop, error) {
@@ -71,6 +73,7 @@ func (r *QueryResolver) SearchStopLocation(ctx context.Context, location model.L
 type QueryTravelPlanner struct {
 	Planner   services.TravelPlanner
 	Scheduler services.TravelScheduler
+	Location  *time.Location // timezone of the agencies, used when no datetime is given
 }
 
 func (r *QueryTravelPlanner) TravelPlanner(ctx context.Context, origin model.Location, destination model.Location, options schema.TravelPlannerOptions) (schema.TravelSchedulePayload, error) {
@@ -79,14 +82,16 @@ func (r *QueryTravelPlanner) TravelPlanner(ctx context.Context, origin model.Loc
 	var err error
 
 	if options.Datetime == nil {
-		now := time.Now()
+		now := time.Now().In(r.Location)
 		options.Datetime = &now
 	}
 
+	plannerOptions := newPlannerOptions(options)
+
 	if options.Mode == schema.ScheduleModeArriveBy {
-		plan, err = r.Planner.Arrive(*options.Datetime, origin, destination)
+		plan, err = r.Planner.Arrive(*options.Datetime, origin, destination, plannerOptions)
 	} else {
-		plan, err = r.Planner.Depart(*options.Datetime, origin, destination)
+		plan, err = r.Planner.Depart(*options.Datetime, origin, destination, plannerOptions)
 	}
 
 	if err != nil {
@@ -102,7 +107,7 @@ func (r *QueryTravelPlanner) TravelPlanner(ctx context.Context, origin model.Loc
 
 func (r *QueryTravelPlanner) TravelPlannerFixedRoute(ctx context.Context, plan model.TravelPlan, options schema.TravelPlannerOptions) (schema.TravelSchedulePayload, error) {
 	if options.Datetime == nil {
-		now := time.Now()
+		now := time.Now().In(r.Location)
 		options.Datetime = &now
 	}
 
@@ -111,7 +116,7 @@ func (r *QueryTravelPlanner) TravelPlannerFixedRoute(ctx context.Context, plan m
 
 func (r *QueryTravelPlanner) TravelPlannerFixedRoutes(ctx context.Context, plans []model.TravelPlan, options schema.TravelPlannerOptions) ([]schema.TravelSchedulePayload, error) {
 	if options.Datetime == nil {
-		now := time.Now()
+		now := time.Now().In(r.Location)
 		options.Datetime = &now
 	}
 
@@ -128,9 +133,9 @@ func (r *QueryTravelPlanner) schedule(plan *model.TravelPlan, options schema.Tra
 	var schedule *model.TravelSchedule
 
 	if options.Mode == schema.ScheduleModeArriveBy {
-		schedule, err = r.Scheduler.Arrive(*options.Datetime, plan)
+		schedule, err = r.Scheduler.Arrive(*options.Datetime, plan, newPlannerOptions(options))
 	} else {
-		schedule, err = r.Scheduler.Depart(*options.Datetime, plan)
+		schedule, err = r.Scheduler.Depart(*options.Datetime, plan, newPlannerOptions(options))
 	}
 
 	if err != nil {
@@ -145,3 +150,27 @@ func (r *QueryTravelPlanner) schedule(plan *model.TravelPlan, options schema.Tra
 		Error:    nil,
 	}
 }
+
+func newPlannerOptions(options schema.TravelPlannerOptions) travel.PlannerOptions {
+	plannerOptions := travel.PlannerOptions{
+		Accessible: options.Accessible != nil && *options.Accessible,
+		Realtime:   options.Realtime != nil && *options.Realtime,
+	}
+	for _, mode := range options.Modes {
+		plannerOptions.Modes = append(plannerOptions.Modes, model.RouteMode(mode))
+	}
+	return plannerOptions
+}
+
+func (r *QueryResolver) Alerts(ctx context.Context, active *bool) ([]model.Alert, error) {
+	if r.ServiceAlerts == nil {
+		return []model.Alert{}, nil
+	}
+
+	alerts := r.ServiceAlerts.All()
+	if active != nil && *active {
+		now := time.Now()
+		return alertsDuring(alerts, now, now), nil
+	}
+	return alerts, nil
+}
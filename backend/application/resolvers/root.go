@@ -3,8 +3,11 @@ package resolvers
 import "stop-checker.com/application/schema"
 
 type Root struct {
+	schema.AlertResolver
+	schema.AlertPeriodResolver
 	schema.BusResolver
 	schema.LocationResolver
+	schema.PredictionResolver
 	schema.QueryResolver
 	schema.RouteResolver
 	schema.ScheduleResolver
@@ -13,11 +16,21 @@ type Root struct {
 	schema.StopResolver
 	schema.StopRouteResolver
 	schema.StopTimeResolver
+	schema.SubscriptionResolver
 	schema.TransitResolver
 	schema.TravelScheduleResolver
 	schema.TravelScheduleLegResolver
 	schema.TravelScheduleNodeResolver
 	schema.TripResolver
+	schema.VehicleResolver
+}
+
+func (r *Root) Alert() schema.AlertResolver {
+	return r.AlertResolver
+}
+
+func (r *Root) AlertPeriod() schema.AlertPeriodResolver {
+	return r.AlertPeriodResolver
 }
 
 func (r *Root) Bus() schema.BusResolver {
@@ -28,6 +41,10 @@ func (r *Root) Location() schema.LocationResolver {
 	return r.LocationResolver
 }
 
+func (r *Root) Prediction() schema.PredictionResolver {
+	return r.PredictionResolver
+}
+
 func (r *Root) Query() schema.QueryResolver {
 	return r.QueryResolver
 }
@@ -60,6 +77,10 @@ func (r *Root) StopTime() schema.StopTimeResolver {
 	return r.StopTimeResolver
 }
 
+func (r *Root) Subscription() schema.SubscriptionResolver {
+	return r.SubscriptionResolver
+}
+
 func (r *Root) Transit() schema.TransitResolver {
 	return r.TransitResolver
 }
@@ -79,3 +100,7 @@ func (r *Root) TravelScheduleNode() schema.TravelScheduleNodeResolver {
 func (r *Root) Trip() schema.TripResolver {
 	return r.TripResolver
 }
+
+func (r *Root) Vehicle() schema.VehicleResolver {
+	return r.VehicleResolver
+}
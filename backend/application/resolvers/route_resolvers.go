@@ -2,17 +2,28 @@ package resolvers
 
 import (
 	"context"
+	"time"
 
+	"stop-checker.com/application/schema"
+	"stop-checker.com/application/services"
 	"stop-checker.com/db/model"
+	"stop-checker.com/db/repository"
 )
 
 type RouteResolvers struct {
+	repository.Trips
+	VehiclePositions services.VehiclePositions // nil without a GTFS-Realtime feed
+	ServiceAlerts    services.Alerts           // nil without a GTFS-Realtime feed
 }
 
 func (r *RouteResolvers) ID(ctx context.Context, obj *model.Route) (string, error) {
 	return obj.Id, nil
 }
 
+func (r *RouteResolvers) Mode(ctx context.Context, obj *model.Route) (schema.RouteMode, error) {
+	return schema.RouteMode(obj.Mode()), nil
+}
+
 func (r *RouteResolvers) Text(ctx context.Context, obj *model.Route) (string, error) {
 	return obj.TextColor, nil
 }
@@ -20,3 +31,36 @@ func (r *RouteResolvers) Text(ctx context.Context, obj *model.Route) (string, er
 func (r *RouteResolvers) Background(ctx context.Context, obj *model.Route) (string, error) {
 	return obj.BackgroundColor, nil
 }
+
+func (r *RouteResolvers) Vehicles(ctx context.Context, obj *model.Route) ([]model.Vehicle, error) {
+	vehicles := []model.Vehicle{}
+	if r.VehiclePositions == nil {
+		return vehicles, nil
+	}
+
+	for _, vehicle := range r.VehiclePositions.Vehicles() {
+		routeId := vehicle.RouteId
+
+		// feeds can omit the route of the trip
+		if routeId == "" {
+			trip, err := r.Trips.Get(vehicle.TripId)
+			if err != nil {
+				continue
+			}
+			routeId = trip.RouteId
+		}
+
+		if routeId == obj.Id {
+			vehicles = append(vehicles, vehicle)
+		}
+	}
+
+	return vehicles, nil
+}
+
+func (r *RouteResolvers) Alerts(ctx context.Context, obj *model.Route) ([]model.Alert, error) {
+	if r.ServiceAlerts == nil {
+		return []model.Alert{}, nil
+	}
+	return alertsDuring(r.ServiceAlerts.Route(obj.Id), time.Now(), time.Time{}), nil
+}
@@ -1,6 +1,7 @@
 package scalars
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -10,6 +11,21 @@ import (
 	"stop-checker.com/db/model"
 )
 
+type locationKey struct{}
+
+// WithLocation of dates and times from clients, the agency's timezone of the data serving the request
+func WithLocation(ctx context.Context, l *time.Location) context.Context {
+	return context.WithValue(ctx, locationKey{}, l)
+}
+
+// location of the request, the local timezone without one
+func getLocation(ctx context.Context) *time.Location {
+	if l, ok := ctx.Value(locationKey{}).(*time.Location); ok && l != nil {
+		return l
+	}
+	return time.Local
+}
+
 func MarshalTime(t model.Time) graphql.Marshaler {
 	return graphql.WriterFunc(func(w io.Writer) {
 		str := t.String()
@@ -18,23 +34,23 @@ func MarshalTime(t model.Time) graphql.Marshaler {
 }
 
 func UnmarshalTime(v interface{}) (model.Time, error) {
-	return model.NewTime(0, 0), errors.New("Unmarshalling 'Time' not implemented")
+	return model.NewTime(0, 0, 0), errors.New("Unmarshalling 'Time' not implemented")
 }
 
-func MarshalDate(t time.Time) graphql.Marshaler {
-	return graphql.WriterFunc(func(w io.Writer) {
-		w.Write([]byte(t.Format("\"2006-01-02\"")))
+func MarshalDate(t time.Time) graphql.ContextMarshaler {
+	return graphql.ContextWriterFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := w.Write([]byte(t.Format("\"2006-01-02\"")))
+		return err
 	})
 }
 
-func UnmarshalDate(v interface{}) (time.Time, error) {
-	// TODO: fix timezone
+func UnmarshalDate(ctx context.Context, v interface{}) (time.Time, error) {
 	date, ok := v.(string)
 	if !ok {
 		return time.Time{}, errors.New("'Date' scalar must be a string")
 	}
 
-	t, err := time.ParseInLocation("2006-01-02", date, time.Local)
+	t, err := time.ParseInLocation("2006-01-02", date, getLocation(ctx))
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -42,13 +58,14 @@ func UnmarshalDate(v interface{}) (time.Time, error) {
 	return t, nil
 }
 
-func MarshalDateTime(t time.Time) graphql.Marshaler {
-	return graphql.WriterFunc(func(w io.Writer) {
-		w.Write([]byte(t.UTC().Format("\"2006-01-02T15:04:00Z\"")))
+func MarshalDateTime(t time.Time) graphql.ContextMarshaler {
+	return graphql.ContextWriterFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := w.Write([]byte(t.UTC().Format("\"2006-01-02T15:04:00Z\"")))
+		return err
 	})
 }
 
-func UnmarshalDateTime(v interface{}) (time.Time, error) {
+func UnmarshalDateTime(ctx context.Context, v interface{}) (time.Time, error) {
 	date, ok := v.(string)
 	if !ok {
 		return time.Time{}, errors.New("'DateTime' scalar must be a string")
@@ -60,5 +77,5 @@ func UnmarshalDateTime(v interface{}) (time.Time, error) {
 		return time.Time{}, err
 	}
 
-	return t.In(time.Local), nil
+	return t.In(getLocation(ctx)), nil
 }
@@ -9,11 +9,12 @@ import (
 )
 
 type ScheduleResolvers struct {
+	Location *time.Location // timezone of the agencies
 }
 
 func (r *ScheduleResolvers) Next(ctx context.Context, obj repository.Schedule, limit int, after *time.Time) ([]model.ScheduleResult, error) {
 	if after == nil {
-		now := time.Now()
+		now := time.Now().In(r.Location)
 		after = &now
 	}
 	return obj.After(*after, limit), nil
@@ -24,8 +25,13 @@ func (r *ScheduleResolvers) On(ctx context.Context, obj repository.Schedule, dat
 }
 
 type ScheduleResultResolvers struct {
+	repository.Trips
 }
 
 func (r *ScheduleResultResolvers) Datetime(ctx context.Context, obj *model.ScheduleResult) (time.Time, error) {
 	return obj.Time, nil
 }
+
+func (r *ScheduleResultResolvers) Headsign(ctx context.Context, obj *model.ScheduleResult) (string, error) {
+	return stopTimeHeadsign(r.Trips, obj.StopTime)
+}
@@ -2,13 +2,19 @@ package resolvers
 
 import (
 	"context"
+	"time"
 
+	"stop-checker.com/application/schema"
+	"stop-checker.com/application/services"
 	"stop-checker.com/db/model"
 	"stop-checker.com/db/repository"
 )
 
 type StopResolvers struct {
+	repository.Stops
 	repository.StopRoutes
+	StopsByParent repository.InvertedIndex[model.Stop]
+	ServiceAlerts services.Alerts // nil without a GTFS-Realtime feed
 }
 
 func (r *StopResolvers) ID(ctx context.Context, obj *model.Stop) (string, error) {
@@ -16,5 +22,36 @@ func (r *StopResolvers) ID(ctx context.Context, obj *model.Stop) (string, error)
 }
 
 func (r *StopResolvers) Routes(ctx context.Context, obj *model.Stop) ([]model.StopRoute, error) {
-	return r.StopRoutes.Get(obj.ID()), nil
+	routes := append([]model.StopRoute{}, r.StopRoutes.Get(obj.ID())...)
+
+	// stations don't have stop times, their platforms do
+	platforms, _ := r.StopsByParent.Get(obj.ID())
+	for _, platform := range platforms {
+		routes = append(routes, r.StopRoutes.Get(platform.ID())...)
+	}
+
+	return routes, nil
+}
+
+func (r *StopResolvers) Parent(ctx context.Context, obj *model.Stop) (*model.Stop, error) {
+	if obj.Parent == "" {
+		return nil, nil
+	}
+	return nullable(r.Stops.Get(obj.Parent)), nil
+}
+
+func (r *StopResolvers) Platforms(ctx context.Context, obj *model.Stop) ([]model.Stop, error) {
+	platforms, _ := r.StopsByParent.Get(obj.ID())
+	return platforms, nil
+}
+
+func (r *StopResolvers) Wheelchair(ctx context.Context, obj *model.Stop) (schema.Accessibility, error) {
+	return accessibility(obj.Wheelchair), nil
+}
+
+func (r *StopResolvers) Alerts(ctx context.Context, obj *model.Stop) ([]model.Alert, error) {
+	if r.ServiceAlerts == nil {
+		return []model.Alert{}, nil
+	}
+	return alertsDuring(r.ServiceAlerts.Stop(obj.Id), time.Now(), time.Time{}), nil
 }
@@ -2,6 +2,7 @@ package resolvers
 
 import (
 	"context"
+	"time"
 
 	"stop-checker.com/application/services"
 	"stop-checker.com/db/model"
@@ -20,7 +21,9 @@ type StopRouteResolvers struct {
 	repository.Schedules
 	Reach StopRouteResolversReach
 	services.OCTranspo
+	OCTranspoAgency string // live data is only available for stops from OC Transpo's feed
 	services.StaticMapEncoder
+	ServiceAlerts services.Alerts // nil without a GTFS-Realtime feed
 }
 
 func (r *StopRouteResolvers) Stop(ctx context.Context, obj *model.StopRoute) (model.Stop, error) {
@@ -40,7 +43,7 @@ func (r *StopRouteResolvers) Schedule(ctx context.Context, obj *model.StopRoute)
 }
 
 func (r *StopRouteResolvers) ScheduleReaches(ctx context.Context, obj *model.StopRoute, destination string) (repository.Schedule, error) {
-	schedule, _ := r.Reach.ReachableBetweenWithSchedule(obj.StopId, destination, obj.RouteId)
+	schedule, _ := r.Reach.ReachableBetweenWithSchedule(obj.StopId, destination, obj.RouteId, nil)
 	return schedule, nil
 }
 
@@ -50,8 +53,7 @@ func (r *StopRouteResolvers) Reaches(ctx context.Context, obj *model.StopRoute,
 
 func (r *StopRouteResolvers) LiveMap(ctx context.Context, obj *model.StopRoute) (*string, error) {
 	stop, _ := r.Stops.Get(obj.StopId)
-	route, _ := r.Routes.Get(obj.RouteId)
-	buses, _ := r.OCTranspo.StopRouteData(stop, route.Name, obj.DirectionId)
+	buses := r.liveBuses(stop, obj)
 
 	// create the map
 	m, err := staticmaps.NewStopRouteMap(800, 400, stop.Location, buses)
@@ -65,7 +67,22 @@ func (r *StopRouteResolvers) LiveMap(ctx context.Context, obj *model.StopRoute)
 
 func (r *StopRouteResolvers) LiveBuses(ctx context.Context, obj *model.StopRoute) ([]model.Bus, error) {
 	stop, _ := r.Stops.Get(obj.StopId)
+	return r.liveBuses(stop, obj), nil
+}
+
+func (r *StopRouteResolvers) liveBuses(stop model.Stop, obj *model.StopRoute) []model.Bus {
+	if !hasLiveData(stop, r.OCTranspoAgency) {
+		return []model.Bus{}
+	}
+
 	route, _ := r.Routes.Get(obj.RouteId)
 	buses, _ := r.OCTranspo.StopRouteData(stop, route.Name, obj.DirectionId)
-	return buses, nil
+	return buses
+}
+
+func (r *StopRouteResolvers) Alerts(ctx context.Context, obj *model.StopRoute) ([]model.Alert, error) {
+	if r.ServiceAlerts == nil {
+		return []model.Alert{}, nil
+	}
+	return alertsDuring(r.ServiceAlerts.StopRoute(obj.StopId, obj.RouteId), time.Now(), time.Time{}), nil
 }
@@ -3,6 +3,7 @@ package resolvers
 import (
 	"context"
 
+	"stop-checker.com/application/schema"
 	"stop-checker.com/db/model"
 	"stop-checker.com/db/repository"
 )
@@ -19,3 +20,56 @@ func (r *StopTimeResolvers) Stop(ctx context.Context, obj *model.StopTime) (mode
 func (r *StopTimeResolvers) Trip(ctx context.Context, obj *model.StopTime) (model.Trip, error) {
 	return r.Trips.Get(obj.TripId)
 }
+
+func (r *StopTimeResolvers) Time(ctx context.Context, obj *model.StopTime) (model.Time, error) {
+	return obj.Departure, nil
+}
+
+func (r *StopTimeResolvers) Overflow(ctx context.Context, obj *model.StopTime) (bool, error) {
+	return obj.Departure.Days() > 0, nil
+}
+
+func (r *StopTimeResolvers) Headsign(ctx context.Context, obj *model.StopTime) (string, error) {
+	return stopTimeHeadsign(r.Trips, *obj)
+}
+
+// headsign at the stop, falls back to the trip headsign
+func stopTimeHeadsign(trips repository.Trips, stopTime model.StopTime) (string, error) {
+	if stopTime.Headsign != "" {
+		return stopTime.Headsign, nil
+	}
+
+	trip, err := trips.Get(stopTime.TripId)
+	if err != nil {
+		return "", err
+	}
+	return trip.Headsign, nil
+}
+
+func (r *StopTimeResolvers) Pickup(ctx context.Context, obj *model.StopTime) (schema.PickupDropOff, error) {
+	return pickupDropOff(obj.Pickup), nil
+}
+
+func (r *StopTimeResolvers) DropOff(ctx context.Context, obj *model.StopTime) (schema.PickupDropOff, error) {
+	return pickupDropOff(obj.DropOff), nil
+}
+
+func (r *StopTimeResolvers) PickupOnly(ctx context.Context, obj *model.StopTime) (bool, error) {
+	return !obj.CanAlight() && obj.CanBoard(), nil
+}
+
+func (r *StopTimeResolvers) DropOffOnly(ctx context.Context, obj *model.StopTime) (bool, error) {
+	return !obj.CanBoard() && obj.CanAlight(), nil
+}
+
+func pickupDropOff(t model.StopType) schema.PickupDropOff {
+	switch t {
+	case model.StopTypeNone:
+		return schema.PickupDropOffNone
+	case model.StopTypePhoneAgency:
+		return schema.PickupDropOffPhoneAgency
+	case model.StopTypeCoordinateWithDriver:
+		return schema.PickupDropOffCoordinateWithDriver
+	}
+	return schema.PickupDropOffRegular
+}
@@ -0,0 +1,37 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+
+	"stop-checker.com/application/services"
+	"stop-checker.com/db/model"
+	"stop-checker.com/db/repository"
+)
+
+type SubscriptionResolver struct {
+	repository.Stops
+	repository.Routes
+	repository.StopRoutes
+	services.LiveBuses
+	OCTranspoAgency string // live data is only available for stops from OC Transpo's feed
+}
+
+func (r *SubscriptionResolver) StopArrivals(ctx context.Context, stopId string, routeId string) (<-chan []model.Bus, error) {
+	stop, err := r.Stops.Get(stopId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasLiveData(stop, r.OCTranspoAgency) {
+		return nil, errors.New("live data is not available for the stop")
+	}
+
+	for _, stopRoute := range r.StopRoutes.Get(stopId) {
+		if stopRoute.RouteId == routeId {
+			route, _ := r.Routes.Get(routeId)
+			return r.LiveBuses.Subscribe(ctx, stop, route.Name, stopRoute.DirectionId), nil
+		}
+	}
+	return nil, errors.New("route does not serve the stop")
+}
@@ -3,6 +3,7 @@ package resolvers
 import (
 	"context"
 
+	"stop-checker.com/application/services"
 	"stop-checker.com/db/model"
 	"stop-checker.com/db/repository"
 )
@@ -15,6 +16,7 @@ func (r *TravelScheduleResolvers) Duration(ctx context.Context, obj *model.Trave
 }
 
 type TravelScheduleLegResolvers struct {
+	ServiceAlerts services.Alerts // nil without a GTFS-Realtime feed
 }
 
 func (r *TravelScheduleLegResolvers) Duration(ctx context.Context, obj *model.TravelScheduleLeg) (int, error) {
@@ -29,3 +31,31 @@ type TravelScheduleNodeResolvers struct {
 func (r *TravelScheduleNodeResolvers) Stop(ctx context.Context, obj *model.TravelScheduleNode) (*model.Stop, error) {
 	return nullable(r.Stops.Get(obj.Id)), nil
 }
+
+// alerts of the stops of the leg, and the route and trip of transit legs
+func (r *TravelScheduleLegResolvers) Alerts(ctx context.Context, obj *model.TravelScheduleLeg) ([]model.Alert, error) {
+	if r.ServiceAlerts == nil {
+		return []model.Alert{}, nil
+	}
+
+	alerts := []model.Alert{}
+	if obj.Transit != nil {
+		alerts = append(alerts, r.ServiceAlerts.StopRoute(obj.Origin.Id, obj.Transit.RouteId)...)
+		alerts = append(alerts, r.ServiceAlerts.StopRoute(obj.Destination.Id, obj.Transit.RouteId)...)
+		alerts = append(alerts, r.ServiceAlerts.Trip(obj.Transit.TripId)...)
+	} else {
+		alerts = append(alerts, r.ServiceAlerts.Stop(obj.Origin.Id)...)
+		alerts = append(alerts, r.ServiceAlerts.Stop(obj.Destination.Id)...)
+	}
+
+	// the origin and destination can share alerts of the route
+	unique := []model.Alert{}
+	seen := map[string]struct{}{}
+	for _, alert := range alertsDuring(alerts, obj.Origin.Arrival, obj.Destination.Arrival) {
+		if _, ok := seen[alert.Id]; !ok {
+			seen[alert.Id] = struct{}{}
+			unique = append(unique, alert)
+		}
+	}
+	return unique, nil
+}
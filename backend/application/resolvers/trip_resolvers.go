@@ -3,6 +3,8 @@ package resolvers
 import (
 	"context"
 
+	"stop-checker.com/application/schema"
+	"stop-checker.com/application/services"
 	"stop-checker.com/db/model"
 	"stop-checker.com/db/repository"
 )
@@ -11,7 +13,9 @@ type TripResolvers struct {
 	repository.Routes
 	repository.Shapes
 	repository.Services
-	StopTimesByTrip repository.InvertedIndex[model.StopTime]
+	StopTimesByTrip  repository.InvertedIndex[model.StopTime]
+	TripUpdates      services.TripUpdates      // nil without a GTFS-Realtime feed
+	VehiclePositions services.VehiclePositions // nil without a GTFS-Realtime feed
 }
 
 func (r *TripResolvers) ID(ctx context.Context, obj *model.Trip) (string, error) {
@@ -41,6 +45,51 @@ func (r *TripResolvers) Service(ctx context.Context, obj *model.Trip) (model.Ser
 	return r.Services.Get(obj.ServiceId)
 }
 
+func (r *TripResolvers) Headway(ctx context.Context, obj *model.Trip) (*int, error) {
+	if obj.Headway == 0 {
+		return nil, nil
+	}
+	minutes := int(obj.Headway.Minutes())
+	return &minutes, nil
+}
+
+func (r *TripResolvers) Wheelchair(ctx context.Context, obj *model.Trip) (schema.Accessibility, error) {
+	return accessibility(obj.Wheelchair), nil
+}
+
+func (r *TripResolvers) Bikes(ctx context.Context, obj *model.Trip) (schema.Accessibility, error) {
+	return accessibility(obj.Bikes), nil
+}
+
 func (r *TripResolvers) Direction(ctx context.Context, obj *model.Trip) (string, error) {
 	return obj.DirectionId, nil
 }
+
+func (r *TripResolvers) Predictions(ctx context.Context, obj *model.Trip) ([]model.Prediction, error) {
+	if r.TripUpdates == nil {
+		return nil, nil
+	}
+
+	stopTimes, err := r.StopTimesByTrip.Get(obj.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	predictions, ok := r.TripUpdates.Predict(stopTimes)
+	if !ok {
+		return nil, nil
+	}
+	return predictions, nil
+}
+
+func (r *TripResolvers) Vehicle(ctx context.Context, obj *model.Trip) (*model.Vehicle, error) {
+	if r.VehiclePositions == nil {
+		return nil, nil
+	}
+
+	vehicle, ok := r.VehiclePositions.Trip(obj.ID())
+	if !ok {
+		return nil, nil
+	}
+	return &vehicle, nil
+}
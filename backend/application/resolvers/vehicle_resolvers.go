@@ -0,0 +1,25 @@
+package resolvers
+
+import (
+	"context"
+
+	"stop-checker.com/db/model"
+	"stop-checker.com/db/repository"
+)
+
+type VehicleResolvers struct {
+	repository.Trips
+}
+
+func (r *VehicleResolvers) Trip(ctx context.Context, obj *model.Vehicle) (*model.Trip, error) {
+	if obj.TripId == "" {
+		return nil, nil
+	}
+
+	// vehicles can be on trips that were filtered from the DB
+	trip, err := r.Trips.Get(obj.TripId)
+	if err != nil {
+		return nil, nil
+	}
+	return &trip, nil
+}
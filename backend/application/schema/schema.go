@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -39,8 +40,11 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	Alert() AlertResolver
+	AlertPeriod() AlertPeriodResolver
 	Bus() BusResolver
 	Location() LocationResolver
+	Prediction() PredictionResolver
 	Query() QueryResolver
 	Route() RouteResolver
 	Schedule() ScheduleResolver
@@ -49,17 +53,35 @@ type ResolverRoot interface {
 	Stop() StopResolver
 	StopRoute() StopRouteResolver
 	StopTime() StopTimeResolver
+	Subscription() SubscriptionResolver
 	Transit() TransitResolver
 	TravelSchedule() TravelScheduleResolver
 	TravelScheduleLeg() TravelScheduleLegResolver
 	TravelScheduleNode() TravelScheduleNodeResolver
 	Trip() TripResolver
+	Vehicle() VehicleResolver
 }
 
 type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	Alert struct {
+		Active      func(childComplexity int) int
+		Cause       func(childComplexity int) int
+		Description func(childComplexity int) int
+		Effect      func(childComplexity int) int
+		Header      func(childComplexity int) int
+		Id          func(childComplexity int) int
+		Periods     func(childComplexity int) int
+		URL         func(childComplexity int) int
+	}
+
+	AlertPeriod struct {
+		End   func(childComplexity int) int
+		Start func(childComplexity int) int
+	}
+
 	Bus struct {
 		Arrival            func(childComplexity int) int
 		Distance           func(childComplexity int) int
@@ -86,7 +108,16 @@ type ComplexityRoot struct {
 		Path     func(childComplexity int) int
 	}
 
+	Prediction struct {
+		Arrival   func(childComplexity int) int
+		Delay     func(childComplexity int) int
+		Departure func(childComplexity int) int
+		Skipped   func(childComplexity int) int
+		StopTime  func(childComplexity int) int
+	}
+
 	Query struct {
+		Alerts                   func(childComplexity int, active *bool) int
 		SearchStopLocation       func(childComplexity int, location model.Location, radius float64, page PageInput, sorted bool) int
 		SearchStopText           func(childComplexity int, text string, page PageInput) int
 		Stop                     func(childComplexity int, id string) int
@@ -97,10 +128,17 @@ type ComplexityRoot struct {
 	}
 
 	Route struct {
-		Background func(childComplexity int) int
-		ID         func(childComplexity int) int
-		Name       func(childComplexity int) int
-		Text       func(childComplexity int) int
+		Agency      func(childComplexity int) int
+		Alerts      func(childComplexity int) int
+		Background  func(childComplexity int) int
+		Description func(childComplexity int) int
+		ID          func(childComplexity int) int
+		LongName    func(childComplexity int) int
+		Mode        func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Text        func(childComplexity int) int
+		URL         func(childComplexity int) int
+		Vehicles    func(childComplexity int) int
 	}
 
 	Schedule struct {
@@ -110,6 +148,7 @@ type ComplexityRoot struct {
 
 	ScheduleResult struct {
 		Datetime func(childComplexity int) int
+		Headsign func(childComplexity int) int
 		StopTime func(childComplexity int) int
 	}
 
@@ -132,14 +171,20 @@ type ComplexityRoot struct {
 	}
 
 	Stop struct {
-		Code     func(childComplexity int) int
-		ID       func(childComplexity int) int
-		Location func(childComplexity int) int
-		Name     func(childComplexity int) int
-		Routes   func(childComplexity int) int
+		Agency     func(childComplexity int) int
+		Alerts     func(childComplexity int) int
+		Code       func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Location   func(childComplexity int) int
+		Name       func(childComplexity int) int
+		Parent     func(childComplexity int) int
+		Platforms  func(childComplexity int) int
+		Routes     func(childComplexity int) int
+		Wheelchair func(childComplexity int) int
 	}
 
 	StopRoute struct {
+		Alerts          func(childComplexity int) int
 		Direction       func(childComplexity int) int
 		Headsign        func(childComplexity int) int
 		LiveBuses       func(childComplexity int) int
@@ -157,12 +202,24 @@ type ComplexityRoot struct {
 	}
 
 	StopTime struct {
-		ID       func(childComplexity int) int
-		Overflow func(childComplexity int) int
-		Sequence func(childComplexity int) int
-		Stop     func(childComplexity int) int
-		Time     func(childComplexity int) int
-		Trip     func(childComplexity int) int
+		Approximate func(childComplexity int) int
+		Arrival     func(childComplexity int) int
+		Departure   func(childComplexity int) int
+		DropOff     func(childComplexity int) int
+		DropOffOnly func(childComplexity int) int
+		Headsign    func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Overflow    func(childComplexity int) int
+		Pickup      func(childComplexity int) int
+		PickupOnly  func(childComplexity int) int
+		Sequence    func(childComplexity int) int
+		Stop        func(childComplexity int) int
+		Time        func(childComplexity int) int
+		Trip        func(childComplexity int) int
+	}
+
+	Subscription struct {
+		StopArrivals func(childComplexity int, stopID string, routeID string) int
 	}
 
 	Transit struct {
@@ -181,6 +238,7 @@ type ComplexityRoot struct {
 	}
 
 	TravelScheduleLeg struct {
+		Alerts      func(childComplexity int) int
 		Destination func(childComplexity int) int
 		Duration    func(childComplexity int) int
 		Origin      func(childComplexity int) int
@@ -200,16 +258,39 @@ type ComplexityRoot struct {
 	}
 
 	Trip struct {
-		Direction func(childComplexity int) int
-		Headsign  func(childComplexity int) int
-		ID        func(childComplexity int) int
-		Route     func(childComplexity int) int
-		Service   func(childComplexity int) int
-		Shape     func(childComplexity int) int
-		Stoptimes func(childComplexity int) int
-	}
+		Bikes       func(childComplexity int) int
+		Direction   func(childComplexity int) int
+		Headsign    func(childComplexity int) int
+		Headway     func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Predictions func(childComplexity int) int
+		Route       func(childComplexity int) int
+		Service     func(childComplexity int) int
+		Shape       func(childComplexity int) int
+		Stoptimes   func(childComplexity int) int
+		Vehicle     func(childComplexity int) int
+		Wheelchair  func(childComplexity int) int
+	}
+
+	Vehicle struct {
+		Bearing     func(childComplexity int) int
+		Id          func(childComplexity int) int
+		Label       func(childComplexity int) int
+		LastUpdated func(childComplexity int) int
+		Location    func(childComplexity int) int
+		Speed       func(childComplexity int) int
+		Trip        func(childComplexity int) int
+	}
+}
+
+type AlertResolver interface {
+	Active(ctx context.Context, obj *model.Alert) (bool, error)
+}
+type AlertPeriodResolver interface {
+	Start(ctx context.Context, obj *model.AlertPeriod) (*time.Time, error)
+	End(ctx context.Context, obj *model.AlertPeriod) (*time.Time, error)
 }
-
 type BusResolver interface {
 	LastUpdatedMinutes(ctx context.Context, obj *model.Bus) (int, error)
 	LastUpdatedMessage(ctx context.Context, obj *model.Bus) (string, error)
@@ -218,6 +299,11 @@ type BusResolver interface {
 type LocationResolver interface {
 	Distance(ctx context.Context, obj *model.Location, location model.Location) (float64, error)
 }
+type PredictionResolver interface {
+	Arrival(ctx context.Context, obj *model.Prediction) (*time.Time, error)
+	Departure(ctx context.Context, obj *model.Prediction) (*time.Time, error)
+	Delay(ctx context.Context, obj *model.Prediction) (int, error)
+}
 type QueryResolver interface {
 	Stop(ctx context.Context, id string) (*model.Stop, error)
 	StopRoute(ctx context.Context, stopID string, routeID string) (*model.StopRoute, error)
@@ -226,12 +312,16 @@ type QueryResolver interface {
 	TravelPlanner(ctx context.Context, origin model.Location, destination model.Location, options TravelPlannerOptions) (TravelSchedulePayload, error)
 	TravelPlannerFixedRoute(ctx context.Context, input model.TravelPlan, options TravelPlannerOptions) (TravelSchedulePayload, error)
 	TravelPlannerFixedRoutes(ctx context.Context, input []model.TravelPlan, options TravelPlannerOptions) ([]TravelSchedulePayload, error)
+	Alerts(ctx context.Context, active *bool) ([]model.Alert, error)
 }
 type RouteResolver interface {
 	ID(ctx context.Context, obj *model.Route) (string, error)
 
+	Mode(ctx context.Context, obj *model.Route) (RouteMode, error)
 	Text(ctx context.Context, obj *model.Route) (string, error)
 	Background(ctx context.Context, obj *model.Route) (string, error)
+	Vehicles(ctx context.Context, obj *model.Route) ([]model.Vehicle, error)
+	Alerts(ctx context.Context, obj *model.Route) ([]model.Alert, error)
 }
 type ScheduleResolver interface {
 	Next(ctx context.Context, obj repository.Schedule, limit int, after *time.Time) ([]model.ScheduleResult, error)
@@ -255,6 +345,10 @@ type StopResolver interface {
 	ID(ctx context.Context, obj *model.Stop) (string, error)
 
 	Routes(ctx context.Context, obj *model.Stop) ([]model.StopRoute, error)
+	Parent(ctx context.Context, obj *model.Stop) (*model.Stop, error)
+	Platforms(ctx context.Context, obj *model.Stop) ([]model.Stop, error)
+	Wheelchair(ctx context.Context, obj *model.Stop) (Accessibility, error)
+	Alerts(ctx context.Context, obj *model.Stop) ([]model.Alert, error)
 }
 type StopRouteResolver interface {
 	Stop(ctx context.Context, obj *model.StopRoute) (model.Stop, error)
@@ -266,10 +360,23 @@ type StopRouteResolver interface {
 	Reaches(ctx context.Context, obj *model.StopRoute, forward bool) ([]model.Stop, error)
 	LiveMap(ctx context.Context, obj *model.StopRoute) (*string, error)
 	LiveBuses(ctx context.Context, obj *model.StopRoute) ([]model.Bus, error)
+	Alerts(ctx context.Context, obj *model.StopRoute) ([]model.Alert, error)
 }
 type StopTimeResolver interface {
 	Stop(ctx context.Context, obj *model.StopTime) (model.Stop, error)
 	Trip(ctx context.Context, obj *model.StopTime) (model.Trip, error)
+
+	Time(ctx context.Context, obj *model.StopTime) (model.Time, error)
+
+	Overflow(ctx context.Context, obj *model.StopTime) (bool, error)
+
+	Pickup(ctx context.Context, obj *model.StopTime) (PickupDropOff, error)
+	DropOff(ctx context.Context, obj *model.StopTime) (PickupDropOff, error)
+	PickupOnly(ctx context.Context, obj *model.StopTime) (bool, error)
+	DropOffOnly(ctx context.Context, obj *model.StopTime) (bool, error)
+}
+type SubscriptionResolver interface {
+	StopArrivals(ctx context.Context, stopID string, routeID string) (<-chan []model.Bus, error)
 }
 type TransitResolver interface {
 	Route(ctx context.Context, obj *model.Transit) (model.Route, error)
@@ -283,6 +390,8 @@ type TravelScheduleResolver interface {
 }
 type TravelScheduleLegResolver interface {
 	Duration(ctx context.Context, obj *model.TravelScheduleLeg) (int, error)
+
+	Alerts(ctx context.Context, obj *model.TravelScheduleLeg) ([]model.Alert, error)
 }
 type TravelScheduleNodeResolver interface {
 	Stop(ctx context.Context, obj *model.TravelScheduleNode) (*model.Stop, error)
@@ -294,6 +403,15 @@ type TripResolver interface {
 	Shape(ctx context.Context, obj *model.Trip) ([]model.Location, error)
 	Service(ctx context.Context, obj *model.Trip) (model.Service, error)
 	Direction(ctx context.Context, obj *model.Trip) (string, error)
+
+	Headway(ctx context.Context, obj *model.Trip) (*int, error)
+	Wheelchair(ctx context.Context, obj *model.Trip) (Accessibility, error)
+	Bikes(ctx context.Context, obj *model.Trip) (Accessibility, error)
+	Predictions(ctx context.Context, obj *model.Trip) ([]model.Prediction, error)
+	Vehicle(ctx context.Context, obj *model.Trip) (*model.Vehicle, error)
+}
+type VehicleResolver interface {
+	Trip(ctx context.Context, obj *model.Vehicle) (*model.Trip, error)
 }
 
 type executableSchema struct {
@@ -311,6 +429,76 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "Alert.active":
+		if e.complexity.Alert.Active == nil {
+			break
+		}
+
+		return e.complexity.Alert.Active(childComplexity), true
+
+	case "Alert.cause":
+		if e.complexity.Alert.Cause == nil {
+			break
+		}
+
+		return e.complexity.Alert.Cause(childComplexity), true
+
+	case "Alert.description":
+		if e.complexity.Alert.Description == nil {
+			break
+		}
+
+		return e.complexity.Alert.Description(childComplexity), true
+
+	case "Alert.effect":
+		if e.complexity.Alert.Effect == nil {
+			break
+		}
+
+		return e.complexity.Alert.Effect(childComplexity), true
+
+	case "Alert.header":
+		if e.complexity.Alert.Header == nil {
+			break
+		}
+
+		return e.complexity.Alert.Header(childComplexity), true
+
+	case "Alert.id":
+		if e.complexity.Alert.Id == nil {
+			break
+		}
+
+		return e.complexity.Alert.Id(childComplexity), true
+
+	case "Alert.periods":
+		if e.complexity.Alert.Periods == nil {
+			break
+		}
+
+		return e.complexity.Alert.Periods(childComplexity), true
+
+	case "Alert.url":
+		if e.complexity.Alert.URL == nil {
+			break
+		}
+
+		return e.complexity.Alert.URL(childComplexity), true
+
+	case "AlertPeriod.end":
+		if e.complexity.AlertPeriod.End == nil {
+			break
+		}
+
+		return e.complexity.AlertPeriod.End(childComplexity), true
+
+	case "AlertPeriod.start":
+		if e.complexity.AlertPeriod.Start == nil {
+			break
+		}
+
+		return e.complexity.AlertPeriod.Start(childComplexity), true
+
 	case "Bus.arrival":
 		if e.complexity.Bus.Arrival == nil {
 			break
@@ -414,6 +602,53 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Path.Path(childComplexity), true
 
+	case "Prediction.arrival":
+		if e.complexity.Prediction.Arrival == nil {
+			break
+		}
+
+		return e.complexity.Prediction.Arrival(childComplexity), true
+
+	case "Prediction.delay":
+		if e.complexity.Prediction.Delay == nil {
+			break
+		}
+
+		return e.complexity.Prediction.Delay(childComplexity), true
+
+	case "Prediction.departure":
+		if e.complexity.Prediction.Departure == nil {
+			break
+		}
+
+		return e.complexity.Prediction.Departure(childComplexity), true
+
+	case "Prediction.skipped":
+		if e.complexity.Prediction.Skipped == nil {
+			break
+		}
+
+		return e.complexity.Prediction.Skipped(childComplexity), true
+
+	case "Prediction.stopTime":
+		if e.complexity.Prediction.StopTime == nil {
+			break
+		}
+
+		return e.complexity.Prediction.StopTime(childComplexity), true
+
+	case "Query.alerts":
+		if e.complexity.Query.Alerts == nil {
+			break
+		}
+
+		args, err := ec.field_Query_alerts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Alerts(childComplexity, args["active"].(*bool)), true
+
 	case "Query.searchStopLocation":
 		if e.complexity.Query.SearchStopLocation == nil {
 			break
@@ -498,6 +733,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.TravelPlannerFixedRoutes(childComplexity, args["input"].([]model.TravelPlan), args["options"].(TravelPlannerOptions)), true
 
+	case "Route.agency":
+		if e.complexity.Route.Agency == nil {
+			break
+		}
+
+		return e.complexity.Route.Agency(childComplexity), true
+
+	case "Route.alerts":
+		if e.complexity.Route.Alerts == nil {
+			break
+		}
+
+		return e.complexity.Route.Alerts(childComplexity), true
+
 	case "Route.background":
 		if e.complexity.Route.Background == nil {
 			break
@@ -505,6 +754,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Route.Background(childComplexity), true
 
+	case "Route.description":
+		if e.complexity.Route.Description == nil {
+			break
+		}
+
+		return e.complexity.Route.Description(childComplexity), true
+
 	case "Route.id":
 		if e.complexity.Route.ID == nil {
 			break
@@ -512,6 +768,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Route.ID(childComplexity), true
 
+	case "Route.longName":
+		if e.complexity.Route.LongName == nil {
+			break
+		}
+
+		return e.complexity.Route.LongName(childComplexity), true
+
+	case "Route.mode":
+		if e.complexity.Route.Mode == nil {
+			break
+		}
+
+		return e.complexity.Route.Mode(childComplexity), true
+
 	case "Route.name":
 		if e.complexity.Route.Name == nil {
 			break
@@ -526,6 +796,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Route.Text(childComplexity), true
 
+	case "Route.url":
+		if e.complexity.Route.URL == nil {
+			break
+		}
+
+		return e.complexity.Route.URL(childComplexity), true
+
+	case "Route.vehicles":
+		if e.complexity.Route.Vehicles == nil {
+			break
+		}
+
+		return e.complexity.Route.Vehicles(childComplexity), true
+
 	case "Schedule.next":
 		if e.complexity.Schedule.Next == nil {
 			break
@@ -557,6 +841,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ScheduleResult.Datetime(childComplexity), true
 
+	case "ScheduleResult.headsign":
+		if e.complexity.ScheduleResult.Headsign == nil {
+			break
+		}
+
+		return e.complexity.ScheduleResult.Headsign(childComplexity), true
+
 	case "ScheduleResult.stoptime":
 		if e.complexity.ScheduleResult.StopTime == nil {
 			break
@@ -648,6 +939,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ServiceException.Date(childComplexity), true
 
+	case "Stop.agency":
+		if e.complexity.Stop.Agency == nil {
+			break
+		}
+
+		return e.complexity.Stop.Agency(childComplexity), true
+
+	case "Stop.alerts":
+		if e.complexity.Stop.Alerts == nil {
+			break
+		}
+
+		return e.complexity.Stop.Alerts(childComplexity), true
+
 	case "Stop.code":
 		if e.complexity.Stop.Code == nil {
 			break
@@ -676,6 +981,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Stop.Name(childComplexity), true
 
+	case "Stop.parent":
+		if e.complexity.Stop.Parent == nil {
+			break
+		}
+
+		return e.complexity.Stop.Parent(childComplexity), true
+
+	case "Stop.platforms":
+		if e.complexity.Stop.Platforms == nil {
+			break
+		}
+
+		return e.complexity.Stop.Platforms(childComplexity), true
+
 	case "Stop.routes":
 		if e.complexity.Stop.Routes == nil {
 			break
@@ -683,6 +1002,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Stop.Routes(childComplexity), true
 
+	case "Stop.wheelchair":
+		if e.complexity.Stop.Wheelchair == nil {
+			break
+		}
+
+		return e.complexity.Stop.Wheelchair(childComplexity), true
+
+	case "StopRoute.alerts":
+		if e.complexity.StopRoute.Alerts == nil {
+			break
+		}
+
+		return e.complexity.StopRoute.Alerts(childComplexity), true
+
 	case "StopRoute.direction":
 		if e.complexity.StopRoute.Direction == nil {
 			break
@@ -770,6 +1103,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.StopSearchPayload.Results(childComplexity), true
 
+	case "StopTime.approximate":
+		if e.complexity.StopTime.Approximate == nil {
+			break
+		}
+
+		return e.complexity.StopTime.Approximate(childComplexity), true
+
+	case "StopTime.arrival":
+		if e.complexity.StopTime.Arrival == nil {
+			break
+		}
+
+		return e.complexity.StopTime.Arrival(childComplexity), true
+
+	case "StopTime.departure":
+		if e.complexity.StopTime.Departure == nil {
+			break
+		}
+
+		return e.complexity.StopTime.Departure(childComplexity), true
+
+	case "StopTime.dropOff":
+		if e.complexity.StopTime.DropOff == nil {
+			break
+		}
+
+		return e.complexity.StopTime.DropOff(childComplexity), true
+
+	case "StopTime.dropOffOnly":
+		if e.complexity.StopTime.DropOffOnly == nil {
+			break
+		}
+
+		return e.complexity.StopTime.DropOffOnly(childComplexity), true
+
+	case "StopTime.headsign":
+		if e.complexity.StopTime.Headsign == nil {
+			break
+		}
+
+		return e.complexity.StopTime.Headsign(childComplexity), true
+
 	case "StopTime.id":
 		if e.complexity.StopTime.ID == nil {
 			break
@@ -784,6 +1159,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.StopTime.Overflow(childComplexity), true
 
+	case "StopTime.pickup":
+		if e.complexity.StopTime.Pickup == nil {
+			break
+		}
+
+		return e.complexity.StopTime.Pickup(childComplexity), true
+
+	case "StopTime.pickupOnly":
+		if e.complexity.StopTime.PickupOnly == nil {
+			break
+		}
+
+		return e.complexity.StopTime.PickupOnly(childComplexity), true
+
 	case "StopTime.sequence":
 		if e.complexity.StopTime.Sequence == nil {
 			break
@@ -812,6 +1201,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.StopTime.Trip(childComplexity), true
 
+	case "Subscription.stopArrivals":
+		if e.complexity.Subscription.StopArrivals == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_stopArrivals_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.StopArrivals(childComplexity, args["stopId"].(string), args["routeId"].(string)), true
+
 	case "Transit.departure":
 		if e.complexity.Transit.Departure == nil {
 			break
@@ -875,6 +1276,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TravelSchedule.Origin(childComplexity), true
 
+	case "TravelScheduleLeg.alerts":
+		if e.complexity.TravelScheduleLeg.Alerts == nil {
+			break
+		}
+
+		return e.complexity.TravelScheduleLeg.Alerts(childComplexity), true
+
 	case "TravelScheduleLeg.destination":
 		if e.complexity.TravelScheduleLeg.Destination == nil {
 			break
@@ -945,6 +1353,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TravelSchedulePayload.Schedule(childComplexity), true
 
+	case "Trip.bikes":
+		if e.complexity.Trip.Bikes == nil {
+			break
+		}
+
+		return e.complexity.Trip.Bikes(childComplexity), true
+
 	case "Trip.direction":
 		if e.complexity.Trip.Direction == nil {
 			break
@@ -959,6 +1374,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Trip.Headsign(childComplexity), true
 
+	case "Trip.headway":
+		if e.complexity.Trip.Headway == nil {
+			break
+		}
+
+		return e.complexity.Trip.Headway(childComplexity), true
+
 	case "Trip.id":
 		if e.complexity.Trip.ID == nil {
 			break
@@ -966,6 +1388,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Trip.ID(childComplexity), true
 
+	case "Trip.name":
+		if e.complexity.Trip.Name == nil {
+			break
+		}
+
+		return e.complexity.Trip.Name(childComplexity), true
+
+	case "Trip.predictions":
+		if e.complexity.Trip.Predictions == nil {
+			break
+		}
+
+		return e.complexity.Trip.Predictions(childComplexity), true
+
 	case "Trip.route":
 		if e.complexity.Trip.Route == nil {
 			break
@@ -994,6 +1430,69 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Trip.Stoptimes(childComplexity), true
 
+	case "Trip.vehicle":
+		if e.complexity.Trip.Vehicle == nil {
+			break
+		}
+
+		return e.complexity.Trip.Vehicle(childComplexity), true
+
+	case "Trip.wheelchair":
+		if e.complexity.Trip.Wheelchair == nil {
+			break
+		}
+
+		return e.complexity.Trip.Wheelchair(childComplexity), true
+
+	case "Vehicle.bearing":
+		if e.complexity.Vehicle.Bearing == nil {
+			break
+		}
+
+		return e.complexity.Vehicle.Bearing(childComplexity), true
+
+	case "Vehicle.id":
+		if e.complexity.Vehicle.Id == nil {
+			break
+		}
+
+		return e.complexity.Vehicle.Id(childComplexity), true
+
+	case "Vehicle.label":
+		if e.complexity.Vehicle.Label == nil {
+			break
+		}
+
+		return e.complexity.Vehicle.Label(childComplexity), true
+
+	case "Vehicle.lastUpdated":
+		if e.complexity.Vehicle.LastUpdated == nil {
+			break
+		}
+
+		return e.complexity.Vehicle.LastUpdated(childComplexity), true
+
+	case "Vehicle.location":
+		if e.complexity.Vehicle.Location == nil {
+			break
+		}
+
+		return e.complexity.Vehicle.Location(childComplexity), true
+
+	case "Vehicle.speed":
+		if e.complexity.Vehicle.Speed == nil {
+			break
+		}
+
+		return e.complexity.Vehicle.Speed(childComplexity), true
+
+	case "Vehicle.trip":
+		if e.complexity.Vehicle.Trip == nil {
+			break
+		}
+
+		return e.complexity.Vehicle.Trip(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -1022,6 +1521,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -1061,7 +1577,33 @@ enum ScheduleMode {
   DEPART_AT
 }
 
-type Location {
+enum RouteMode {
+  LRT # tram, streetcar, light rail
+  SUBWAY
+  RAIL
+  BUS
+  FERRY
+  CABLE_TRAM
+  AERIAL_LIFT
+  FUNICULAR
+  TROLLEYBUS
+  MONORAIL
+}
+
+enum PickupDropOff {
+  REGULAR
+  NONE
+  PHONE_AGENCY
+  COORDINATE_WITH_DRIVER
+}
+
+enum Accessibility {
+  UNKNOWN
+  ACCESSIBLE
+  NOT_ACCESSIBLE
+}
+
+type Location {
   latitude: Float!
   longitude: Float!
   distance(location: LocationInput!): Float!
@@ -1076,8 +1618,29 @@ type Stop {
   id: ID!
   name: String!
   code: String!
+  agency: String!
   location: Location!
-  routes: [StopRoute!]!
+  routes: [StopRoute!]! # routes of the platforms for stations
+  parent: Stop # station of a platform
+  platforms: [Stop!]! # platforms of a station
+  wheelchair: Accessibility! # wheelchair boarding
+  alerts: [Alert!]! # current and upcoming alerts for every route at the stop
+}
+
+type Alert {
+  id: ID!
+  header: String!
+  description: String!
+  url: String!
+  cause: String! # CONSTRUCTION, WEATHER...
+  effect: String! # DETOUR, NO_SERVICE...
+  active: Boolean! # active now
+  periods: [AlertPeriod!]! # the alert is always active without periods
+}
+
+type AlertPeriod {
+  start: Datetime # null when the period has no start
+  end: Datetime # null when the period has no end
 }
 
 type Bus {
@@ -1100,6 +1663,7 @@ type StopRoute {
   reaches(forward: Boolean!): [Stop!]! # stops that this stop route reaches
   liveMap: String
   liveBuses: [Bus!]!
+  alerts: [Alert!]! # current and upcoming alerts for the stop, the route, and the route at the stop
 }
 
 type Schedule {
@@ -1110,13 +1674,21 @@ type Schedule {
 type ScheduleResult {
   stoptime: StopTime!
   datetime: Datetime!
+  headsign: String! # headsign shown at the stop
 }
 
 type Route {
   id: ID!
+  agency: String!
   name: String!
+  longName: String!
+  description: String!
+  url: String!
+  mode: RouteMode!
   text: Color!
   background: Color!
+  vehicles: [Vehicle!]! # vehicles on the route from GTFS-Realtime
+  alerts: [Alert!]! # current and upcoming alerts for every stop of the route
 }
 
 type Trip {
@@ -1127,15 +1699,47 @@ type Trip {
   service: Service!
   direction: ID!
   headsign: String!
+  name: String! # trip number shown to riders
+  headway: Int # minutes between departures of frequency-based trips without exact times
+  wheelchair: Accessibility!
+  bikes: Accessibility!
+  predictions: [Prediction!] # realtime arrivals and departures, null when the trip has no update
+  vehicle: Vehicle # vehicle on the trip from GTFS-Realtime
+}
+
+type Vehicle {
+  id: ID!
+  label: String! # label shown to riders, the bus number
+  trip: Trip # null when the vehicle is not on a trip
+  location: Location!
+  bearing: Float # degrees clockwise from north
+  speed: Float # meters per second
+  lastUpdated: Datetime!
+}
+
+type Prediction {
+  stopTime: StopTime!
+  arrival: Datetime # null when the delay is unknown
+  departure: Datetime # null when the delay is unknown
+  delay: Int! # minutes late, negative when early
+  skipped: Boolean! # the trip does not stop here or is canceled
 }
 
 type StopTime {
   id: ID!
   stop: Stop!
   trip: Trip!
-  time: Time!
+  arrival: Time! # arrival at the stop
+  departure: Time! # departure from the stop
+  time: Time! @deprecated(reason: "use departure")
   sequence: Int!
   overflow: Boolean!
+  headsign: String! # headsign shown at the stop
+  pickup: PickupDropOff!
+  dropOff: PickupDropOff!
+  pickupOnly: Boolean! # riders cannot get off
+  dropOffOnly: Boolean! # riders cannot get on
+  approximate: Boolean! # the time is an estimate
 }
 
 type Service {
@@ -1174,6 +1778,7 @@ type TravelScheduleLeg {
   duration: Int! # minutes
   transit: Transit
   walk: Path
+  alerts: [Alert!]! # alerts for the stops, route and trip active during the leg
 }
 
 type TravelScheduleNode {
@@ -1193,6 +1798,9 @@ type Transit {
 input TravelPlannerOptions {
   datetime: Datetime
   mode: ScheduleMode!
+  modes: [RouteMode!] # route modes to travel by, all modes when null
+  accessible: Boolean # only use wheelchair accessible stops and trips
+  realtime: Boolean # use predicted times and skip canceled trips in the near-term
 }
 
 input TravelPlanInput {
@@ -1245,6 +1853,14 @@ type Query {
     input: [TravelPlanInput!]!
     options: TravelPlannerOptions!
   ): [TravelSchedulePayload!]!
+
+  # service alerts, only the alerts active now when active is true
+  alerts(active: Boolean): [Alert!]!
+}
+
+type Subscription {
+  # live buses of the route arriving at the stop, pushed when they change
+  stopArrivals(stopId: ID!, routeId: ID!): [Bus!]!
 }
 
 type PageInfo {
@@ -1299,6 +1915,21 @@ func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_alerts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *bool
+	if tmp, ok := rawArgs["active"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("active"))
+		arg0, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["active"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_searchStopLocation_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1554,6 +2185,30 @@ func (ec *executionContext) field_StopRoute_scheduleReaches_args(ctx context.Con
 	return args, nil
 }
 
+func (ec *executionContext) field_Subscription_stopArrivals_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["stopId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stopId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["stopId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["routeId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("routeId"))
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["routeId"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1592,8 +2247,8 @@ func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArg
 
 // region    **************************** field.gotpl *****************************
 
-func (ec *executionContext) _Bus_headsign(ctx context.Context, field graphql.CollectedField, obj *model.Bus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Bus_headsign(ctx, field)
+func (ec *executionContext) _Alert_id(ctx context.Context, field graphql.CollectedField, obj *model.Alert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Alert_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1606,7 +2261,7 @@ func (ec *executionContext) _Bus_headsign(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Headsign, nil
+		return obj.Id, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1620,24 +2275,24 @@ func (ec *executionContext) _Bus_headsign(ctx context.Context, field graphql.Col
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Bus_headsign(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Alert_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Bus",
+		Object:     "Alert",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Bus_arrival(ctx context.Context, field graphql.CollectedField, obj *model.Bus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Bus_arrival(ctx, field)
+func (ec *executionContext) _Alert_header(ctx context.Context, field graphql.CollectedField, obj *model.Alert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Alert_header(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1650,7 +2305,7 @@ func (ec *executionContext) _Bus_arrival(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Arrival, nil
+		return obj.Header, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1662,26 +2317,26 @@ func (ec *executionContext) _Bus_arrival(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNDatetime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Bus_arrival(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Alert_header(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Bus",
+		Object:     "Alert",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Datetime does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Bus_lastUpdated(ctx context.Context, field graphql.CollectedField, obj *model.Bus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Bus_lastUpdated(ctx, field)
+func (ec *executionContext) _Alert_description(ctx context.Context, field graphql.CollectedField, obj *model.Alert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Alert_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1694,7 +2349,7 @@ func (ec *executionContext) _Bus_lastUpdated(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastUpdated, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1706,26 +2361,26 @@ func (ec *executionContext) _Bus_lastUpdated(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNDatetime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Bus_lastUpdated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Alert_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Bus",
+		Object:     "Alert",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Datetime does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Bus_lastUpdatedMinutes(ctx context.Context, field graphql.CollectedField, obj *model.Bus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Bus_lastUpdatedMinutes(ctx, field)
+func (ec *executionContext) _Alert_url(ctx context.Context, field graphql.CollectedField, obj *model.Alert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Alert_url(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1738,7 +2393,7 @@ func (ec *executionContext) _Bus_lastUpdatedMinutes(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Bus().LastUpdatedMinutes(rctx, obj)
+		return obj.URL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1750,26 +2405,26 @@ func (ec *executionContext) _Bus_lastUpdatedMinutes(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Bus_lastUpdatedMinutes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Alert_url(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Bus",
+		Object:     "Alert",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Bus_lastUpdatedMessage(ctx context.Context, field graphql.CollectedField, obj *model.Bus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Bus_lastUpdatedMessage(ctx, field)
+func (ec *executionContext) _Alert_cause(ctx context.Context, field graphql.CollectedField, obj *model.Alert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Alert_cause(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1782,7 +2437,7 @@ func (ec *executionContext) _Bus_lastUpdatedMessage(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Bus().LastUpdatedMessage(rctx, obj)
+		return obj.Cause, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1799,12 +2454,12 @@ func (ec *executionContext) _Bus_lastUpdatedMessage(ctx context.Context, field g
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Bus_lastUpdatedMessage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Alert_cause(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Bus",
+		Object:     "Alert",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -1812,8 +2467,8 @@ func (ec *executionContext) fieldContext_Bus_lastUpdatedMessage(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _Bus_distance(ctx context.Context, field graphql.CollectedField, obj *model.Bus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Bus_distance(ctx, field)
+func (ec *executionContext) _Alert_effect(ctx context.Context, field graphql.CollectedField, obj *model.Alert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Alert_effect(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1826,35 +2481,38 @@ func (ec *executionContext) _Bus_distance(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Bus().Distance(rctx, obj)
+		return obj.Effect, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Bus_distance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Alert_effect(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Bus",
+		Object:     "Alert",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Bus_location(ctx context.Context, field graphql.CollectedField, obj *model.Bus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Bus_location(ctx, field)
+func (ec *executionContext) _Alert_active(ctx context.Context, field graphql.CollectedField, obj *model.Alert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Alert_active(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1867,43 +2525,38 @@ func (ec *executionContext) _Bus_location(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Location, nil
+		return ec.resolvers.Alert().Active(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Location)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOLocation2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐLocation(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Bus_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Alert_active(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Bus",
+		Object:     "Alert",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "latitude":
-				return ec.fieldContext_Location_latitude(ctx, field)
-			case "longitude":
-				return ec.fieldContext_Location_longitude(ctx, field)
-			case "distance":
-				return ec.fieldContext_Location_distance(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_latitude(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Location_latitude(ctx, field)
+func (ec *executionContext) _Alert_periods(ctx context.Context, field graphql.CollectedField, obj *model.Alert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Alert_periods(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1916,7 +2569,7 @@ func (ec *executionContext) _Location_latitude(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Latitude, nil
+		return obj.Periods, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1928,26 +2581,32 @@ func (ec *executionContext) _Location_latitude(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.([]model.AlertPeriod)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNAlertPeriod2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐAlertPeriodᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Location_latitude(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Alert_periods(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "Alert",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			switch field.Name {
+			case "start":
+				return ec.fieldContext_AlertPeriod_start(ctx, field)
+			case "end":
+				return ec.fieldContext_AlertPeriod_end(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AlertPeriod", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_longitude(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Location_longitude(ctx, field)
+func (ec *executionContext) _AlertPeriod_start(ctx context.Context, field graphql.CollectedField, obj *model.AlertPeriod) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AlertPeriod_start(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1960,38 +2619,35 @@ func (ec *executionContext) _Location_longitude(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Longitude, nil
+		return ec.resolvers.AlertPeriod().Start(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalODatetime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Location_longitude(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AlertPeriod_start(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "AlertPeriod",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Datetime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_distance(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Location_distance(ctx, field)
+func (ec *executionContext) _AlertPeriod_end(ctx context.Context, field graphql.CollectedField, obj *model.AlertPeriod) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AlertPeriod_end(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2004,49 +2660,35 @@ func (ec *executionContext) _Location_distance(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Location().Distance(rctx, obj, fc.Args["location"].(model.Location))
+		return ec.resolvers.AlertPeriod().End(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalODatetime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Location_distance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AlertPeriod_end(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "AlertPeriod",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Datetime does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Location_distance_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_cursor(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_cursor(ctx, field)
+func (ec *executionContext) _Bus_headsign(ctx context.Context, field graphql.CollectedField, obj *model.Bus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Bus_headsign(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2059,7 +2701,7 @@ func (ec *executionContext) _PageInfo_cursor(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor, nil
+		return obj.Headsign, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2071,26 +2713,26 @@ func (ec *executionContext) _PageInfo_cursor(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Bus_headsign(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "Bus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_remaining(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_remaining(ctx, field)
+func (ec *executionContext) _Bus_arrival(ctx context.Context, field graphql.CollectedField, obj *model.Bus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Bus_arrival(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2103,7 +2745,7 @@ func (ec *executionContext) _PageInfo_remaining(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Remaining, nil
+		return obj.Arrival, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2115,26 +2757,26 @@ func (ec *executionContext) _PageInfo_remaining(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNDatetime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_remaining(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Bus_arrival(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "Bus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Datetime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Path_distance(ctx context.Context, field graphql.CollectedField, obj *model.Path) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Path_distance(ctx, field)
+func (ec *executionContext) _Bus_lastUpdated(ctx context.Context, field graphql.CollectedField, obj *model.Bus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Bus_lastUpdated(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2147,7 +2789,7 @@ func (ec *executionContext) _Path_distance(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Distance, nil
+		return obj.LastUpdated, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2159,26 +2801,26 @@ func (ec *executionContext) _Path_distance(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNDatetime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Path_distance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Bus_lastUpdated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Path",
+		Object:     "Bus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Datetime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Path_path(ctx context.Context, field graphql.CollectedField, obj *model.Path) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Path_path(ctx, field)
+func (ec *executionContext) _Bus_lastUpdatedMinutes(ctx context.Context, field graphql.CollectedField, obj *model.Bus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Bus_lastUpdatedMinutes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2191,7 +2833,7 @@ func (ec *executionContext) _Path_path(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Path, nil
+		return ec.resolvers.Bus().LastUpdatedMinutes(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2203,34 +2845,26 @@ func (ec *executionContext) _Path_path(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.Location)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNLocation2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐLocationᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Path_path(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Bus_lastUpdatedMinutes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Path",
+		Object:     "Bus",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "latitude":
-				return ec.fieldContext_Location_latitude(ctx, field)
-			case "longitude":
-				return ec.fieldContext_Location_longitude(ctx, field)
-			case "distance":
-				return ec.fieldContext_Location_distance(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_stop(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_stop(ctx, field)
+func (ec *executionContext) _Bus_lastUpdatedMessage(ctx context.Context, field graphql.CollectedField, obj *model.Bus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Bus_lastUpdatedMessage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2243,58 +2877,38 @@ func (ec *executionContext) _Query_stop(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Stop(rctx, fc.Args["id"].(string))
+		return ec.resolvers.Bus().LastUpdatedMessage(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Stop)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOStop2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐStop(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_stop(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Bus_lastUpdatedMessage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Bus",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Stop_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Stop_name(ctx, field)
-			case "code":
-				return ec.fieldContext_Stop_code(ctx, field)
-			case "location":
-				return ec.fieldContext_Stop_location(ctx, field)
-			case "routes":
-				return ec.fieldContext_Stop_routes(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Stop", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_stop_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_stopRoute(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_stopRoute(ctx, field)
+func (ec *executionContext) _Bus_distance(ctx context.Context, field graphql.CollectedField, obj *model.Bus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Bus_distance(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2307,7 +2921,7 @@ func (ec *executionContext) _Query_stopRoute(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().StopRoute(rctx, fc.Args["stopId"].(string), fc.Args["routeId"].(string))
+		return ec.resolvers.Bus().Distance(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2316,57 +2930,75 @@ func (ec *executionContext) _Query_stopRoute(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.StopRoute)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOStopRoute2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐStopRoute(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_stopRoute(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Bus_distance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Bus",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "stop":
-				return ec.fieldContext_StopRoute_stop(ctx, field)
-			case "route":
-				return ec.fieldContext_StopRoute_route(ctx, field)
-			case "direction":
-				return ec.fieldContext_StopRoute_direction(ctx, field)
-			case "headsign":
-				return ec.fieldContext_StopRoute_headsign(ctx, field)
-			case "schedule":
-				return ec.fieldContext_StopRoute_schedule(ctx, field)
-			case "scheduleReaches":
-				return ec.fieldContext_StopRoute_scheduleReaches(ctx, field)
-			case "reaches":
-				return ec.fieldContext_StopRoute_reaches(ctx, field)
-			case "liveMap":
-				return ec.fieldContext_StopRoute_liveMap(ctx, field)
-			case "liveBuses":
-				return ec.fieldContext_StopRoute_liveBuses(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type StopRoute", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Bus_location(ctx context.Context, field graphql.CollectedField, obj *model.Bus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Bus_location(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_stopRoute_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Location, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Location)
+	fc.Result = res
+	return ec.marshalOLocation2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐLocation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Bus_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Bus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "latitude":
+				return ec.fieldContext_Location_latitude(ctx, field)
+			case "longitude":
+				return ec.fieldContext_Location_longitude(ctx, field)
+			case "distance":
+				return ec.fieldContext_Location_distance(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_searchStopText(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_searchStopText(ctx, field)
+func (ec *executionContext) _Location_latitude(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_latitude(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2379,7 +3011,7 @@ func (ec *executionContext) _Query_searchStopText(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().SearchStopText(rctx, fc.Args["text"].(string), fc.Args["page"].(PageInput))
+		return obj.Latitude, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2391,43 +3023,26 @@ func (ec *executionContext) _Query_searchStopText(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(StopSearchPayload)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNStopSearchPayload2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐStopSearchPayload(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_searchStopText(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_latitude(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Location",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "page":
-				return ec.fieldContext_StopSearchPayload_page(ctx, field)
-			case "results":
-				return ec.fieldContext_StopSearchPayload_results(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type StopSearchPayload", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_searchStopText_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_searchStopLocation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_searchStopLocation(ctx, field)
+func (ec *executionContext) _Location_longitude(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_longitude(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2440,7 +3055,7 @@ func (ec *executionContext) _Query_searchStopLocation(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().SearchStopLocation(rctx, fc.Args["location"].(model.Location), fc.Args["radius"].(float64), fc.Args["page"].(PageInput), fc.Args["sorted"].(bool))
+		return obj.Longitude, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2452,43 +3067,26 @@ func (ec *executionContext) _Query_searchStopLocation(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(StopSearchPayload)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNStopSearchPayload2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐStopSearchPayload(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_searchStopLocation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_longitude(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Location",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "page":
-				return ec.fieldContext_StopSearchPayload_page(ctx, field)
-			case "results":
-				return ec.fieldContext_StopSearchPayload_results(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type StopSearchPayload", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_searchStopLocation_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_travelPlanner(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_travelPlanner(ctx, field)
+func (ec *executionContext) _Location_distance(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_distance(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2501,7 +3099,7 @@ func (ec *executionContext) _Query_travelPlanner(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TravelPlanner(rctx, fc.Args["origin"].(model.Location), fc.Args["destination"].(model.Location), fc.Args["options"].(TravelPlannerOptions))
+		return ec.resolvers.Location().Distance(rctx, obj, fc.Args["location"].(model.Location))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2513,25 +3111,19 @@ func (ec *executionContext) _Query_travelPlanner(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(TravelSchedulePayload)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNTravelSchedulePayload2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐTravelSchedulePayload(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_travelPlanner(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_distance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "schedule":
-				return ec.fieldContext_TravelSchedulePayload_schedule(ctx, field)
-			case "error":
-				return ec.fieldContext_TravelSchedulePayload_error(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TravelSchedulePayload", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	defer func() {
@@ -2541,15 +3133,15 @@ func (ec *executionContext) fieldContext_Query_travelPlanner(ctx context.Context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_travelPlanner_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Location_distance_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_travelPlannerFixedRoute(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_travelPlannerFixedRoute(ctx, field)
+func (ec *executionContext) _PageInfo_cursor(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2562,7 +3154,7 @@ func (ec *executionContext) _Query_travelPlannerFixedRoute(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TravelPlannerFixedRoute(rctx, fc.Args["input"].(model.TravelPlan), fc.Args["options"].(TravelPlannerOptions))
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2574,43 +3166,2699 @@ func (ec *executionContext) _Query_travelPlannerFixedRoute(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(TravelSchedulePayload)
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_remaining(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_remaining(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Remaining, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_remaining(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Path_distance(ctx context.Context, field graphql.CollectedField, obj *model.Path) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Path_distance(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Distance, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Path_distance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Path",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Path_path(ctx context.Context, field graphql.CollectedField, obj *model.Path) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Path_path(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.Location)
+	fc.Result = res
+	return ec.marshalNLocation2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐLocationᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Path_path(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Path",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "latitude":
+				return ec.fieldContext_Location_latitude(ctx, field)
+			case "longitude":
+				return ec.fieldContext_Location_longitude(ctx, field)
+			case "distance":
+				return ec.fieldContext_Location_distance(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prediction_stopTime(ctx context.Context, field graphql.CollectedField, obj *model.Prediction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Prediction_stopTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StopTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.StopTime)
+	fc.Result = res
+	return ec.marshalNStopTime2stopᚑcheckerᚗcomᚋdbᚋmodelᚐStopTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Prediction_stopTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prediction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StopTime_id(ctx, field)
+			case "stop":
+				return ec.fieldContext_StopTime_stop(ctx, field)
+			case "trip":
+				return ec.fieldContext_StopTime_trip(ctx, field)
+			case "arrival":
+				return ec.fieldContext_StopTime_arrival(ctx, field)
+			case "departure":
+				return ec.fieldContext_StopTime_departure(ctx, field)
+			case "time":
+				return ec.fieldContext_StopTime_time(ctx, field)
+			case "sequence":
+				return ec.fieldContext_StopTime_sequence(ctx, field)
+			case "overflow":
+				return ec.fieldContext_StopTime_overflow(ctx, field)
+			case "headsign":
+				return ec.fieldContext_StopTime_headsign(ctx, field)
+			case "pickup":
+				return ec.fieldContext_StopTime_pickup(ctx, field)
+			case "dropOff":
+				return ec.fieldContext_StopTime_dropOff(ctx, field)
+			case "pickupOnly":
+				return ec.fieldContext_StopTime_pickupOnly(ctx, field)
+			case "dropOffOnly":
+				return ec.fieldContext_StopTime_dropOffOnly(ctx, field)
+			case "approximate":
+				return ec.fieldContext_StopTime_approximate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StopTime", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prediction_arrival(ctx context.Context, field graphql.CollectedField, obj *model.Prediction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Prediction_arrival(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Prediction().Arrival(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalODatetime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Prediction_arrival(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prediction",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Datetime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prediction_departure(ctx context.Context, field graphql.CollectedField, obj *model.Prediction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Prediction_departure(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Prediction().Departure(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalODatetime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Prediction_departure(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prediction",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Datetime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prediction_delay(ctx context.Context, field graphql.CollectedField, obj *model.Prediction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Prediction_delay(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Prediction().Delay(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Prediction_delay(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prediction",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prediction_skipped(ctx context.Context, field graphql.CollectedField, obj *model.Prediction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Prediction_skipped(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Skipped, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Prediction_skipped(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prediction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_stop(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_stop(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Stop(rctx, fc.Args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Stop)
+	fc.Result = res
+	return ec.marshalOStop2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐStop(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_stop(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Stop_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Stop_name(ctx, field)
+			case "code":
+				return ec.fieldContext_Stop_code(ctx, field)
+			case "agency":
+				return ec.fieldContext_Stop_agency(ctx, field)
+			case "location":
+				return ec.fieldContext_Stop_location(ctx, field)
+			case "routes":
+				return ec.fieldContext_Stop_routes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Stop_parent(ctx, field)
+			case "platforms":
+				return ec.fieldContext_Stop_platforms(ctx, field)
+			case "wheelchair":
+				return ec.fieldContext_Stop_wheelchair(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Stop_alerts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Stop", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_stop_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_stopRoute(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_stopRoute(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().StopRoute(rctx, fc.Args["stopId"].(string), fc.Args["routeId"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.StopRoute)
+	fc.Result = res
+	return ec.marshalOStopRoute2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐStopRoute(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_stopRoute(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "stop":
+				return ec.fieldContext_StopRoute_stop(ctx, field)
+			case "route":
+				return ec.fieldContext_StopRoute_route(ctx, field)
+			case "direction":
+				return ec.fieldContext_StopRoute_direction(ctx, field)
+			case "headsign":
+				return ec.fieldContext_StopRoute_headsign(ctx, field)
+			case "schedule":
+				return ec.fieldContext_StopRoute_schedule(ctx, field)
+			case "scheduleReaches":
+				return ec.fieldContext_StopRoute_scheduleReaches(ctx, field)
+			case "reaches":
+				return ec.fieldContext_StopRoute_reaches(ctx, field)
+			case "liveMap":
+				return ec.fieldContext_StopRoute_liveMap(ctx, field)
+			case "liveBuses":
+				return ec.fieldContext_StopRoute_liveBuses(ctx, field)
+			case "alerts":
+				return ec.fieldContext_StopRoute_alerts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StopRoute", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_stopRoute_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_searchStopText(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_searchStopText(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SearchStopText(rctx, fc.Args["text"].(string), fc.Args["page"].(PageInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(StopSearchPayload)
+	fc.Result = res
+	return ec.marshalNStopSearchPayload2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐStopSearchPayload(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_searchStopText(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "page":
+				return ec.fieldContext_StopSearchPayload_page(ctx, field)
+			case "results":
+				return ec.fieldContext_StopSearchPayload_results(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StopSearchPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_searchStopText_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_searchStopLocation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_searchStopLocation(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SearchStopLocation(rctx, fc.Args["location"].(model.Location), fc.Args["radius"].(float64), fc.Args["page"].(PageInput), fc.Args["sorted"].(bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(StopSearchPayload)
+	fc.Result = res
+	return ec.marshalNStopSearchPayload2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐStopSearchPayload(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_searchStopLocation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "page":
+				return ec.fieldContext_StopSearchPayload_page(ctx, field)
+			case "results":
+				return ec.fieldContext_StopSearchPayload_results(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StopSearchPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_searchStopLocation_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_travelPlanner(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_travelPlanner(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().TravelPlanner(rctx, fc.Args["origin"].(model.Location), fc.Args["destination"].(model.Location), fc.Args["options"].(TravelPlannerOptions))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(TravelSchedulePayload)
+	fc.Result = res
+	return ec.marshalNTravelSchedulePayload2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐTravelSchedulePayload(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_travelPlanner(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "schedule":
+				return ec.fieldContext_TravelSchedulePayload_schedule(ctx, field)
+			case "error":
+				return ec.fieldContext_TravelSchedulePayload_error(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TravelSchedulePayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_travelPlanner_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_travelPlannerFixedRoute(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_travelPlannerFixedRoute(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().TravelPlannerFixedRoute(rctx, fc.Args["input"].(model.TravelPlan), fc.Args["options"].(TravelPlannerOptions))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(TravelSchedulePayload)
 	fc.Result = res
 	return ec.marshalNTravelSchedulePayload2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐTravelSchedulePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_travelPlannerFixedRoute(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_travelPlannerFixedRoute(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "schedule":
+				return ec.fieldContext_TravelSchedulePayload_schedule(ctx, field)
+			case "error":
+				return ec.fieldContext_TravelSchedulePayload_error(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TravelSchedulePayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_travelPlannerFixedRoute_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_travelPlannerFixedRoutes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_travelPlannerFixedRoutes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().TravelPlannerFixedRoutes(rctx, fc.Args["input"].([]model.TravelPlan), fc.Args["options"].(TravelPlannerOptions))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]TravelSchedulePayload)
+	fc.Result = res
+	return ec.marshalNTravelSchedulePayload2ᚕstopᚑcheckerᚗcomᚋapplicationᚋschemaᚐTravelSchedulePayloadᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_travelPlannerFixedRoutes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "schedule":
+				return ec.fieldContext_TravelSchedulePayload_schedule(ctx, field)
+			case "error":
+				return ec.fieldContext_TravelSchedulePayload_error(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TravelSchedulePayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_travelPlannerFixedRoutes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_alerts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_alerts(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Alerts(rctx, fc.Args["active"].(*bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.Alert)
+	fc.Result = res
+	return ec.marshalNAlert2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐAlertᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_alerts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Alert_id(ctx, field)
+			case "header":
+				return ec.fieldContext_Alert_header(ctx, field)
+			case "description":
+				return ec.fieldContext_Alert_description(ctx, field)
+			case "url":
+				return ec.fieldContext_Alert_url(ctx, field)
+			case "cause":
+				return ec.fieldContext_Alert_cause(ctx, field)
+			case "effect":
+				return ec.fieldContext_Alert_effect(ctx, field)
+			case "active":
+				return ec.fieldContext_Alert_active(ctx, field)
+			case "periods":
+				return ec.fieldContext_Alert_periods(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_alerts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Route_id(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Route_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Route().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Route_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Route",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Route_agency(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Route_agency(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Agency, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Route_agency(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Route",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Route_name(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Route_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Route_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Route",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Route_longName(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Route_longName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LongName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Route_longName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Route",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Route_description(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Route_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Route_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Route",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Route_url(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Route_url(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Route_url(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Route",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Route_mode(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Route_mode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Route().Mode(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(RouteMode)
+	fc.Result = res
+	return ec.marshalNRouteMode2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐRouteMode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Route_mode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Route",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type RouteMode does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Route_text(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Route_text(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Route().Text(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNColor2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Route_text(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Route",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Color does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Route_background(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Route_background(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Route().Background(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNColor2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Route_background(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Route",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Color does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Route_vehicles(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Route_vehicles(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Route().Vehicles(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.Vehicle)
+	fc.Result = res
+	return ec.marshalNVehicle2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐVehicleᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Route_vehicles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Route",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Vehicle_id(ctx, field)
+			case "label":
+				return ec.fieldContext_Vehicle_label(ctx, field)
+			case "trip":
+				return ec.fieldContext_Vehicle_trip(ctx, field)
+			case "location":
+				return ec.fieldContext_Vehicle_location(ctx, field)
+			case "bearing":
+				return ec.fieldContext_Vehicle_bearing(ctx, field)
+			case "speed":
+				return ec.fieldContext_Vehicle_speed(ctx, field)
+			case "lastUpdated":
+				return ec.fieldContext_Vehicle_lastUpdated(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Vehicle", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Route_alerts(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Route_alerts(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Route().Alerts(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.Alert)
+	fc.Result = res
+	return ec.marshalNAlert2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐAlertᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Route_alerts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Route",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Alert_id(ctx, field)
+			case "header":
+				return ec.fieldContext_Alert_header(ctx, field)
+			case "description":
+				return ec.fieldContext_Alert_description(ctx, field)
+			case "url":
+				return ec.fieldContext_Alert_url(ctx, field)
+			case "cause":
+				return ec.fieldContext_Alert_cause(ctx, field)
+			case "effect":
+				return ec.fieldContext_Alert_effect(ctx, field)
+			case "active":
+				return ec.fieldContext_Alert_active(ctx, field)
+			case "periods":
+				return ec.fieldContext_Alert_periods(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Schedule_next(ctx context.Context, field graphql.CollectedField, obj repository.Schedule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Schedule_next(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Schedule().Next(rctx, obj, fc.Args["limit"].(int), fc.Args["after"].(*time.Time))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.ScheduleResult)
+	fc.Result = res
+	return ec.marshalNScheduleResult2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐScheduleResultᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Schedule_next(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Schedule",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "stoptime":
+				return ec.fieldContext_ScheduleResult_stoptime(ctx, field)
+			case "datetime":
+				return ec.fieldContext_ScheduleResult_datetime(ctx, field)
+			case "headsign":
+				return ec.fieldContext_ScheduleResult_headsign(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ScheduleResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Schedule_next_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Schedule_on(ctx context.Context, field graphql.CollectedField, obj repository.Schedule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Schedule_on(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Schedule().On(rctx, obj, fc.Args["date"].(time.Time))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.ScheduleResult)
+	fc.Result = res
+	return ec.marshalNScheduleResult2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐScheduleResultᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Schedule_on(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Schedule",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "stoptime":
+				return ec.fieldContext_ScheduleResult_stoptime(ctx, field)
+			case "datetime":
+				return ec.fieldContext_ScheduleResult_datetime(ctx, field)
+			case "headsign":
+				return ec.fieldContext_ScheduleResult_headsign(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ScheduleResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Schedule_on_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScheduleResult_stoptime(ctx context.Context, field graphql.CollectedField, obj *model.ScheduleResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScheduleResult_stoptime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StopTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.StopTime)
+	fc.Result = res
+	return ec.marshalNStopTime2stopᚑcheckerᚗcomᚋdbᚋmodelᚐStopTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ScheduleResult_stoptime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScheduleResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StopTime_id(ctx, field)
+			case "stop":
+				return ec.fieldContext_StopTime_stop(ctx, field)
+			case "trip":
+				return ec.fieldContext_StopTime_trip(ctx, field)
+			case "arrival":
+				return ec.fieldContext_StopTime_arrival(ctx, field)
+			case "departure":
+				return ec.fieldContext_StopTime_departure(ctx, field)
+			case "time":
+				return ec.fieldContext_StopTime_time(ctx, field)
+			case "sequence":
+				return ec.fieldContext_StopTime_sequence(ctx, field)
+			case "overflow":
+				return ec.fieldContext_StopTime_overflow(ctx, field)
+			case "headsign":
+				return ec.fieldContext_StopTime_headsign(ctx, field)
+			case "pickup":
+				return ec.fieldContext_StopTime_pickup(ctx, field)
+			case "dropOff":
+				return ec.fieldContext_StopTime_dropOff(ctx, field)
+			case "pickupOnly":
+				return ec.fieldContext_StopTime_pickupOnly(ctx, field)
+			case "dropOffOnly":
+				return ec.fieldContext_StopTime_dropOffOnly(ctx, field)
+			case "approximate":
+				return ec.fieldContext_StopTime_approximate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StopTime", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScheduleResult_datetime(ctx context.Context, field graphql.CollectedField, obj *model.ScheduleResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScheduleResult_datetime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ScheduleResult().Datetime(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDatetime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ScheduleResult_datetime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScheduleResult",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Datetime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScheduleResult_headsign(ctx context.Context, field graphql.CollectedField, obj *model.ScheduleResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScheduleResult_headsign(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Headsign, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ScheduleResult_headsign(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScheduleResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Service_sunday(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Service_sunday(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Service().Sunday(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Service_sunday(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Service",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Service_monday(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Service_monday(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Service().Monday(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Service_monday(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Service",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Service_tuesday(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Service_tuesday(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Service().Tuesday(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Service_tuesday(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Service",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Service_wednesday(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Service_wednesday(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Service().Wednesday(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Service_wednesday(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Service",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Service_thursday(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Service_thursday(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Service().Thursday(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Service_thursday(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Service",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Service_friday(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Service_friday(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Service().Friday(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Service_friday(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Service",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Service_saturday(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Service_saturday(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Service().Saturday(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Service_saturday(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Service",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Service_start(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Service_start(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Start, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDate2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Service_start(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Service",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Service_end(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Service_end(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.End, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDate2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Service_end(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Service",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Service_exceptions(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Service_exceptions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Service().Exceptions(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.ServiceException)
+	fc.Result = res
+	return ec.marshalNServiceException2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐServiceExceptionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Service_exceptions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Service",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "added":
+				return ec.fieldContext_ServiceException_added(ctx, field)
+			case "date":
+				return ec.fieldContext_ServiceException_date(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ServiceException", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ServiceException_added(ctx context.Context, field graphql.CollectedField, obj *model.ServiceException) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ServiceException_added(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Added, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ServiceException_added(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ServiceException",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ServiceException_date(ctx context.Context, field graphql.CollectedField, obj *model.ServiceException) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ServiceException_date(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Date, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDate2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ServiceException_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ServiceException",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stop_id(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Stop_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Stop().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Stop_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Stop",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stop_name(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Stop_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Stop_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stop",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stop_code(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Stop_code(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Stop_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stop",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stop_agency(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Stop_agency(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Agency, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Stop_agency(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stop",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stop_location(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Stop_location(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Location, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.Location)
+	fc.Result = res
+	return ec.marshalNLocation2stopᚑcheckerᚗcomᚋdbᚋmodelᚐLocation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Stop_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stop",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "schedule":
-				return ec.fieldContext_TravelSchedulePayload_schedule(ctx, field)
-			case "error":
-				return ec.fieldContext_TravelSchedulePayload_error(ctx, field)
+			case "latitude":
+				return ec.fieldContext_Location_latitude(ctx, field)
+			case "longitude":
+				return ec.fieldContext_Location_longitude(ctx, field)
+			case "distance":
+				return ec.fieldContext_Location_distance(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TravelSchedulePayload", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stop_routes(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Stop_routes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_travelPlannerFixedRoute_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Stop().Routes(rctx, obj)
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.StopRoute)
+	fc.Result = res
+	return ec.marshalNStopRoute2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐStopRouteᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Stop_routes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stop",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "stop":
+				return ec.fieldContext_StopRoute_stop(ctx, field)
+			case "route":
+				return ec.fieldContext_StopRoute_route(ctx, field)
+			case "direction":
+				return ec.fieldContext_StopRoute_direction(ctx, field)
+			case "headsign":
+				return ec.fieldContext_StopRoute_headsign(ctx, field)
+			case "schedule":
+				return ec.fieldContext_StopRoute_schedule(ctx, field)
+			case "scheduleReaches":
+				return ec.fieldContext_StopRoute_scheduleReaches(ctx, field)
+			case "reaches":
+				return ec.fieldContext_StopRoute_reaches(ctx, field)
+			case "liveMap":
+				return ec.fieldContext_StopRoute_liveMap(ctx, field)
+			case "liveBuses":
+				return ec.fieldContext_StopRoute_liveBuses(ctx, field)
+			case "alerts":
+				return ec.fieldContext_StopRoute_alerts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StopRoute", field.Name)
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_travelPlannerFixedRoutes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_travelPlannerFixedRoutes(ctx, field)
+func (ec *executionContext) _Stop_parent(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Stop_parent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2623,55 +5871,57 @@ func (ec *executionContext) _Query_travelPlannerFixedRoutes(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TravelPlannerFixedRoutes(rctx, fc.Args["input"].([]model.TravelPlan), fc.Args["options"].(TravelPlannerOptions))
+		return ec.resolvers.Stop().Parent(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]TravelSchedulePayload)
+	res := resTmp.(*model.Stop)
 	fc.Result = res
-	return ec.marshalNTravelSchedulePayload2ᚕstopᚑcheckerᚗcomᚋapplicationᚋschemaᚐTravelSchedulePayloadᚄ(ctx, field.Selections, res)
+	return ec.marshalOStop2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐStop(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_travelPlannerFixedRoutes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Stop_parent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Stop",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "schedule":
-				return ec.fieldContext_TravelSchedulePayload_schedule(ctx, field)
-			case "error":
-				return ec.fieldContext_TravelSchedulePayload_error(ctx, field)
+			case "id":
+				return ec.fieldContext_Stop_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Stop_name(ctx, field)
+			case "code":
+				return ec.fieldContext_Stop_code(ctx, field)
+			case "agency":
+				return ec.fieldContext_Stop_agency(ctx, field)
+			case "location":
+				return ec.fieldContext_Stop_location(ctx, field)
+			case "routes":
+				return ec.fieldContext_Stop_routes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Stop_parent(ctx, field)
+			case "platforms":
+				return ec.fieldContext_Stop_platforms(ctx, field)
+			case "wheelchair":
+				return ec.fieldContext_Stop_wheelchair(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Stop_alerts(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TravelSchedulePayload", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Stop", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_travelPlannerFixedRoutes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _Stop_platforms(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Stop_platforms(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2684,68 +5934,60 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return ec.resolvers.Stop().Platforms(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]model.Stop)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNStop2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐStopᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Stop_platforms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Stop",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
+			case "id":
+				return ec.fieldContext_Stop_id(ctx, field)
 			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+				return ec.fieldContext_Stop_name(ctx, field)
+			case "code":
+				return ec.fieldContext_Stop_code(ctx, field)
+			case "agency":
+				return ec.fieldContext_Stop_agency(ctx, field)
+			case "location":
+				return ec.fieldContext_Stop_location(ctx, field)
+			case "routes":
+				return ec.fieldContext_Stop_routes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Stop_parent(ctx, field)
+			case "platforms":
+				return ec.fieldContext_Stop_platforms(ctx, field)
+			case "wheelchair":
+				return ec.fieldContext_Stop_wheelchair(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Stop_alerts(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Stop", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _Stop_wheelchair(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Stop_wheelchair(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2758,49 +6000,38 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return ec.resolvers.Stop().Wheelchair(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(Accessibility)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNAccessibility2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐAccessibility(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Stop_wheelchair(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Stop",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type Accessibility does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Route_id(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Route_id(ctx, field)
+func (ec *executionContext) _Stop_alerts(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Stop_alerts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2813,7 +6044,7 @@ func (ec *executionContext) _Route_id(ctx context.Context, field graphql.Collect
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Route().ID(rctx, obj)
+		return ec.resolvers.Stop().Alerts(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2825,26 +6056,44 @@ func (ec *executionContext) _Route_id(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]model.Alert)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNAlert2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐAlertᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Route_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Stop_alerts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Route",
+		Object:     "Stop",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Alert_id(ctx, field)
+			case "header":
+				return ec.fieldContext_Alert_header(ctx, field)
+			case "description":
+				return ec.fieldContext_Alert_description(ctx, field)
+			case "url":
+				return ec.fieldContext_Alert_url(ctx, field)
+			case "cause":
+				return ec.fieldContext_Alert_cause(ctx, field)
+			case "effect":
+				return ec.fieldContext_Alert_effect(ctx, field)
+			case "active":
+				return ec.fieldContext_Alert_active(ctx, field)
+			case "periods":
+				return ec.fieldContext_Alert_periods(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Route_name(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Route_name(ctx, field)
+func (ec *executionContext) _StopRoute_stop(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopRoute_stop(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2857,7 +6106,7 @@ func (ec *executionContext) _Route_name(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.StopRoute().Stop(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2869,26 +6118,48 @@ func (ec *executionContext) _Route_name(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.Stop)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNStop2stopᚑcheckerᚗcomᚋdbᚋmodelᚐStop(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Route_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopRoute_stop(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Route",
+		Object:     "StopRoute",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Stop_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Stop_name(ctx, field)
+			case "code":
+				return ec.fieldContext_Stop_code(ctx, field)
+			case "agency":
+				return ec.fieldContext_Stop_agency(ctx, field)
+			case "location":
+				return ec.fieldContext_Stop_location(ctx, field)
+			case "routes":
+				return ec.fieldContext_Stop_routes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Stop_parent(ctx, field)
+			case "platforms":
+				return ec.fieldContext_Stop_platforms(ctx, field)
+			case "wheelchair":
+				return ec.fieldContext_Stop_wheelchair(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Stop_alerts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Stop", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Route_text(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Route_text(ctx, field)
+func (ec *executionContext) _StopRoute_route(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopRoute_route(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2901,7 +6172,7 @@ func (ec *executionContext) _Route_text(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Route().Text(rctx, obj)
+		return ec.resolvers.StopRoute().Route(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2913,26 +6184,50 @@ func (ec *executionContext) _Route_text(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.Route)
 	fc.Result = res
-	return ec.marshalNColor2string(ctx, field.Selections, res)
+	return ec.marshalNRoute2stopᚑcheckerᚗcomᚋdbᚋmodelᚐRoute(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Route_text(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopRoute_route(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Route",
+		Object:     "StopRoute",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Color does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Route_id(ctx, field)
+			case "agency":
+				return ec.fieldContext_Route_agency(ctx, field)
+			case "name":
+				return ec.fieldContext_Route_name(ctx, field)
+			case "longName":
+				return ec.fieldContext_Route_longName(ctx, field)
+			case "description":
+				return ec.fieldContext_Route_description(ctx, field)
+			case "url":
+				return ec.fieldContext_Route_url(ctx, field)
+			case "mode":
+				return ec.fieldContext_Route_mode(ctx, field)
+			case "text":
+				return ec.fieldContext_Route_text(ctx, field)
+			case "background":
+				return ec.fieldContext_Route_background(ctx, field)
+			case "vehicles":
+				return ec.fieldContext_Route_vehicles(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Route_alerts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Route", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Route_background(ctx context.Context, field graphql.CollectedField, obj *model.Route) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Route_background(ctx, field)
+func (ec *executionContext) _StopRoute_direction(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopRoute_direction(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2945,7 +6240,7 @@ func (ec *executionContext) _Route_background(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Route().Background(rctx, obj)
+		return ec.resolvers.StopRoute().Direction(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2959,24 +6254,24 @@ func (ec *executionContext) _Route_background(ctx context.Context, field graphql
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNColor2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Route_background(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopRoute_direction(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Route",
+		Object:     "StopRoute",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Color does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Schedule_next(ctx context.Context, field graphql.CollectedField, obj repository.Schedule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Schedule_next(ctx, field)
+func (ec *executionContext) _StopRoute_headsign(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopRoute_headsign(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2989,7 +6284,7 @@ func (ec *executionContext) _Schedule_next(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Schedule().Next(rctx, obj, fc.Args["limit"].(int), fc.Args["after"].(*time.Time))
+		return obj.Headsign, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3001,43 +6296,26 @@ func (ec *executionContext) _Schedule_next(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.ScheduleResult)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNScheduleResult2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐScheduleResultᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Schedule_next(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopRoute_headsign(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Schedule",
+		Object:     "StopRoute",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "stoptime":
-				return ec.fieldContext_ScheduleResult_stoptime(ctx, field)
-			case "datetime":
-				return ec.fieldContext_ScheduleResult_datetime(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ScheduleResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Schedule_next_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Schedule_on(ctx context.Context, field graphql.CollectedField, obj repository.Schedule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Schedule_on(ctx, field)
+func (ec *executionContext) _StopRoute_schedule(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopRoute_schedule(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3050,7 +6328,7 @@ func (ec *executionContext) _Schedule_on(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Schedule().On(rctx, obj, fc.Args["date"].(time.Time))
+		return ec.resolvers.StopRoute().Schedule(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3062,43 +6340,32 @@ func (ec *executionContext) _Schedule_on(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.ScheduleResult)
+	res := resTmp.(repository.Schedule)
 	fc.Result = res
-	return ec.marshalNScheduleResult2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐScheduleResultᚄ(ctx, field.Selections, res)
+	return ec.marshalNSchedule2stopᚑcheckerᚗcomᚋdbᚋrepositoryᚐSchedule(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Schedule_on(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopRoute_schedule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Schedule",
+		Object:     "StopRoute",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "stoptime":
-				return ec.fieldContext_ScheduleResult_stoptime(ctx, field)
-			case "datetime":
-				return ec.fieldContext_ScheduleResult_datetime(ctx, field)
+			case "next":
+				return ec.fieldContext_Schedule_next(ctx, field)
+			case "on":
+				return ec.fieldContext_Schedule_on(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ScheduleResult", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Schedule", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Schedule_on_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ScheduleResult_stoptime(ctx context.Context, field graphql.CollectedField, obj *model.ScheduleResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ScheduleResult_stoptime(ctx, field)
+func (ec *executionContext) _StopRoute_scheduleReaches(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopRoute_scheduleReaches(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3111,52 +6378,52 @@ func (ec *executionContext) _ScheduleResult_stoptime(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StopTime, nil
+		return ec.resolvers.StopRoute().ScheduleReaches(rctx, obj, fc.Args["destination"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.StopTime)
+	res := resTmp.(repository.Schedule)
 	fc.Result = res
-	return ec.marshalNStopTime2stopᚑcheckerᚗcomᚋdbᚋmodelᚐStopTime(ctx, field.Selections, res)
+	return ec.marshalOSchedule2stopᚑcheckerᚗcomᚋdbᚋrepositoryᚐSchedule(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ScheduleResult_stoptime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopRoute_scheduleReaches(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ScheduleResult",
+		Object:     "StopRoute",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_StopTime_id(ctx, field)
-			case "stop":
-				return ec.fieldContext_StopTime_stop(ctx, field)
-			case "trip":
-				return ec.fieldContext_StopTime_trip(ctx, field)
-			case "time":
-				return ec.fieldContext_StopTime_time(ctx, field)
-			case "sequence":
-				return ec.fieldContext_StopTime_sequence(ctx, field)
-			case "overflow":
-				return ec.fieldContext_StopTime_overflow(ctx, field)
+			case "next":
+				return ec.fieldContext_Schedule_next(ctx, field)
+			case "on":
+				return ec.fieldContext_Schedule_on(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type StopTime", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Schedule", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_StopRoute_scheduleReaches_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ScheduleResult_datetime(ctx context.Context, field graphql.CollectedField, obj *model.ScheduleResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ScheduleResult_datetime(ctx, field)
+func (ec *executionContext) _StopRoute_reaches(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopRoute_reaches(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3169,7 +6436,7 @@ func (ec *executionContext) _ScheduleResult_datetime(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ScheduleResult().Datetime(rctx, obj)
+		return ec.resolvers.StopRoute().Reaches(rctx, obj, fc.Args["forward"].(bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3181,26 +6448,59 @@ func (ec *executionContext) _ScheduleResult_datetime(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.([]model.Stop)
 	fc.Result = res
-	return ec.marshalNDatetime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNStop2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐStopᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ScheduleResult_datetime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopRoute_reaches(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ScheduleResult",
+		Object:     "StopRoute",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Datetime does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Stop_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Stop_name(ctx, field)
+			case "code":
+				return ec.fieldContext_Stop_code(ctx, field)
+			case "agency":
+				return ec.fieldContext_Stop_agency(ctx, field)
+			case "location":
+				return ec.fieldContext_Stop_location(ctx, field)
+			case "routes":
+				return ec.fieldContext_Stop_routes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Stop_parent(ctx, field)
+			case "platforms":
+				return ec.fieldContext_Stop_platforms(ctx, field)
+			case "wheelchair":
+				return ec.fieldContext_Stop_wheelchair(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Stop_alerts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Stop", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_StopRoute_reaches_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Service_sunday(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Service_sunday(ctx, field)
+func (ec *executionContext) _StopRoute_liveMap(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopRoute_liveMap(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3213,38 +6513,35 @@ func (ec *executionContext) _Service_sunday(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Service().Sunday(rctx, obj)
+		return ec.resolvers.StopRoute().LiveMap(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Service_sunday(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopRoute_liveMap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "StopRoute",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Service_monday(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Service_monday(ctx, field)
+func (ec *executionContext) _StopRoute_liveBuses(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopRoute_liveBuses(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3257,7 +6554,7 @@ func (ec *executionContext) _Service_monday(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Service().Monday(rctx, obj)
+		return ec.resolvers.StopRoute().LiveBuses(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3269,26 +6566,42 @@ func (ec *executionContext) _Service_monday(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]model.Bus)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNBus2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐBusᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Service_monday(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopRoute_liveBuses(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "StopRoute",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "headsign":
+				return ec.fieldContext_Bus_headsign(ctx, field)
+			case "arrival":
+				return ec.fieldContext_Bus_arrival(ctx, field)
+			case "lastUpdated":
+				return ec.fieldContext_Bus_lastUpdated(ctx, field)
+			case "lastUpdatedMinutes":
+				return ec.fieldContext_Bus_lastUpdatedMinutes(ctx, field)
+			case "lastUpdatedMessage":
+				return ec.fieldContext_Bus_lastUpdatedMessage(ctx, field)
+			case "distance":
+				return ec.fieldContext_Bus_distance(ctx, field)
+			case "location":
+				return ec.fieldContext_Bus_location(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Bus", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Service_tuesday(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Service_tuesday(ctx, field)
+func (ec *executionContext) _StopRoute_alerts(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopRoute_alerts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3301,7 +6614,7 @@ func (ec *executionContext) _Service_tuesday(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Service().Tuesday(rctx, obj)
+		return ec.resolvers.StopRoute().Alerts(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3313,26 +6626,44 @@ func (ec *executionContext) _Service_tuesday(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]model.Alert)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNAlert2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐAlertᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Service_tuesday(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopRoute_alerts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "StopRoute",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Alert_id(ctx, field)
+			case "header":
+				return ec.fieldContext_Alert_header(ctx, field)
+			case "description":
+				return ec.fieldContext_Alert_description(ctx, field)
+			case "url":
+				return ec.fieldContext_Alert_url(ctx, field)
+			case "cause":
+				return ec.fieldContext_Alert_cause(ctx, field)
+			case "effect":
+				return ec.fieldContext_Alert_effect(ctx, field)
+			case "active":
+				return ec.fieldContext_Alert_active(ctx, field)
+			case "periods":
+				return ec.fieldContext_Alert_periods(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Service_wednesday(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Service_wednesday(ctx, field)
+func (ec *executionContext) _StopSearchPayload_page(ctx context.Context, field graphql.CollectedField, obj *StopSearchPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopSearchPayload_page(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3345,7 +6676,7 @@ func (ec *executionContext) _Service_wednesday(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Service().Wednesday(rctx, obj)
+		return obj.Page, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3357,26 +6688,32 @@ func (ec *executionContext) _Service_wednesday(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*PageInfo)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖstopᚑcheckerᚗcomᚋapplicationᚋschemaᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Service_wednesday(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopSearchPayload_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "StopSearchPayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_PageInfo_cursor(ctx, field)
+			case "remaining":
+				return ec.fieldContext_PageInfo_remaining(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Service_thursday(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Service_thursday(ctx, field)
+func (ec *executionContext) _StopSearchPayload_results(ctx context.Context, field graphql.CollectedField, obj *StopSearchPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopSearchPayload_results(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3389,7 +6726,7 @@ func (ec *executionContext) _Service_thursday(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Service().Thursday(rctx, obj)
+		return obj.Results, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3401,26 +6738,48 @@ func (ec *executionContext) _Service_thursday(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]model.Stop)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNStop2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐStopᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Service_thursday(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopSearchPayload_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "StopSearchPayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Stop_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Stop_name(ctx, field)
+			case "code":
+				return ec.fieldContext_Stop_code(ctx, field)
+			case "agency":
+				return ec.fieldContext_Stop_agency(ctx, field)
+			case "location":
+				return ec.fieldContext_Stop_location(ctx, field)
+			case "routes":
+				return ec.fieldContext_Stop_routes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Stop_parent(ctx, field)
+			case "platforms":
+				return ec.fieldContext_Stop_platforms(ctx, field)
+			case "wheelchair":
+				return ec.fieldContext_Stop_wheelchair(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Stop_alerts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Stop", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Service_friday(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Service_friday(ctx, field)
+func (ec *executionContext) _StopTime_id(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopTime_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3433,7 +6792,7 @@ func (ec *executionContext) _Service_friday(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Service().Friday(rctx, obj)
+		return obj.ID(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3445,26 +6804,26 @@ func (ec *executionContext) _Service_friday(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Service_friday(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopTime_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "StopTime",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Service_saturday(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Service_saturday(ctx, field)
+func (ec *executionContext) _StopTime_stop(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopTime_stop(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3477,7 +6836,7 @@ func (ec *executionContext) _Service_saturday(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Service().Saturday(rctx, obj)
+		return ec.resolvers.StopTime().Stop(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3489,26 +6848,48 @@ func (ec *executionContext) _Service_saturday(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.Stop)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNStop2stopᚑcheckerᚗcomᚋdbᚋmodelᚐStop(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Service_saturday(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopTime_stop(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "StopTime",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Stop_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Stop_name(ctx, field)
+			case "code":
+				return ec.fieldContext_Stop_code(ctx, field)
+			case "agency":
+				return ec.fieldContext_Stop_agency(ctx, field)
+			case "location":
+				return ec.fieldContext_Stop_location(ctx, field)
+			case "routes":
+				return ec.fieldContext_Stop_routes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Stop_parent(ctx, field)
+			case "platforms":
+				return ec.fieldContext_Stop_platforms(ctx, field)
+			case "wheelchair":
+				return ec.fieldContext_Stop_wheelchair(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Stop_alerts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Stop", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Service_start(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Service_start(ctx, field)
+func (ec *executionContext) _StopTime_trip(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopTime_trip(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3521,7 +6902,7 @@ func (ec *executionContext) _Service_start(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Start, nil
+		return ec.resolvers.StopTime().Trip(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3533,26 +6914,54 @@ func (ec *executionContext) _Service_start(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(model.Trip)
 	fc.Result = res
-	return ec.marshalNDate2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNTrip2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTrip(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Service_start(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopTime_trip(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "StopTime",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Trip_id(ctx, field)
+			case "route":
+				return ec.fieldContext_Trip_route(ctx, field)
+			case "stoptimes":
+				return ec.fieldContext_Trip_stoptimes(ctx, field)
+			case "shape":
+				return ec.fieldContext_Trip_shape(ctx, field)
+			case "service":
+				return ec.fieldContext_Trip_service(ctx, field)
+			case "direction":
+				return ec.fieldContext_Trip_direction(ctx, field)
+			case "headsign":
+				return ec.fieldContext_Trip_headsign(ctx, field)
+			case "name":
+				return ec.fieldContext_Trip_name(ctx, field)
+			case "headway":
+				return ec.fieldContext_Trip_headway(ctx, field)
+			case "wheelchair":
+				return ec.fieldContext_Trip_wheelchair(ctx, field)
+			case "bikes":
+				return ec.fieldContext_Trip_bikes(ctx, field)
+			case "predictions":
+				return ec.fieldContext_Trip_predictions(ctx, field)
+			case "vehicle":
+				return ec.fieldContext_Trip_vehicle(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trip", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Service_end(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Service_end(ctx, field)
+func (ec *executionContext) _StopTime_arrival(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopTime_arrival(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3565,7 +6974,7 @@ func (ec *executionContext) _Service_end(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.End, nil
+		return obj.Arrival, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3577,26 +6986,26 @@ func (ec *executionContext) _Service_end(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(model.Time)
 	fc.Result = res
-	return ec.marshalNDate2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNTime2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Service_end(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopTime_arrival(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "StopTime",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Service_exceptions(ctx context.Context, field graphql.CollectedField, obj *model.Service) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Service_exceptions(ctx, field)
+func (ec *executionContext) _StopTime_departure(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopTime_departure(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3609,7 +7018,7 @@ func (ec *executionContext) _Service_exceptions(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Service().Exceptions(rctx, obj)
+		return obj.Departure, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3621,32 +7030,26 @@ func (ec *executionContext) _Service_exceptions(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.ServiceException)
+	res := resTmp.(model.Time)
 	fc.Result = res
-	return ec.marshalNServiceException2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐServiceExceptionᚄ(ctx, field.Selections, res)
+	return ec.marshalNTime2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Service_exceptions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopTime_departure(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Service",
+		Object:     "StopTime",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "added":
-				return ec.fieldContext_ServiceException_added(ctx, field)
-			case "date":
-				return ec.fieldContext_ServiceException_date(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ServiceException", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ServiceException_added(ctx context.Context, field graphql.CollectedField, obj *model.ServiceException) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ServiceException_added(ctx, field)
+func (ec *executionContext) _StopTime_time(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopTime_time(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3659,7 +7062,7 @@ func (ec *executionContext) _ServiceException_added(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Added, nil
+		return ec.resolvers.StopTime().Time(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3671,26 +7074,26 @@ func (ec *executionContext) _ServiceException_added(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.Time)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNTime2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ServiceException_added(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopTime_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ServiceException",
+		Object:     "StopTime",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ServiceException_date(ctx context.Context, field graphql.CollectedField, obj *model.ServiceException) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ServiceException_date(ctx, field)
+func (ec *executionContext) _StopTime_sequence(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopTime_sequence(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3703,7 +7106,7 @@ func (ec *executionContext) _ServiceException_date(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Date, nil
+		return obj.Sequence, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3715,26 +7118,26 @@ func (ec *executionContext) _ServiceException_date(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNDate2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ServiceException_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopTime_sequence(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ServiceException",
+		Object:     "StopTime",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Stop_id(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Stop_id(ctx, field)
+func (ec *executionContext) _StopTime_overflow(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopTime_overflow(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3747,7 +7150,7 @@ func (ec *executionContext) _Stop_id(ctx context.Context, field graphql.Collecte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Stop().ID(rctx, obj)
+		return ec.resolvers.StopTime().Overflow(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3759,26 +7162,26 @@ func (ec *executionContext) _Stop_id(ctx context.Context, field graphql.Collecte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Stop_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopTime_overflow(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Stop",
+		Object:     "StopTime",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Stop_name(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Stop_name(ctx, field)
+func (ec *executionContext) _StopTime_headsign(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopTime_headsign(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3791,7 +7194,7 @@ func (ec *executionContext) _Stop_name(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Headsign, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3808,9 +7211,9 @@ func (ec *executionContext) _Stop_name(ctx context.Context, field graphql.Collec
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Stop_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopTime_headsign(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Stop",
+		Object:     "StopTime",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3821,8 +7224,8 @@ func (ec *executionContext) fieldContext_Stop_name(ctx context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _Stop_code(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Stop_code(ctx, field)
+func (ec *executionContext) _StopTime_pickup(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopTime_pickup(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3835,7 +7238,7 @@ func (ec *executionContext) _Stop_code(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return ec.resolvers.StopTime().Pickup(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3847,26 +7250,26 @@ func (ec *executionContext) _Stop_code(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(PickupDropOff)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPickupDropOff2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐPickupDropOff(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Stop_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopTime_pickup(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Stop",
+		Object:     "StopTime",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type PickupDropOff does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Stop_location(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Stop_location(ctx, field)
+func (ec *executionContext) _StopTime_dropOff(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopTime_dropOff(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3879,7 +7282,7 @@ func (ec *executionContext) _Stop_location(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Location, nil
+		return ec.resolvers.StopTime().DropOff(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3891,34 +7294,26 @@ func (ec *executionContext) _Stop_location(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Location)
+	res := resTmp.(PickupDropOff)
 	fc.Result = res
-	return ec.marshalNLocation2stopᚑcheckerᚗcomᚋdbᚋmodelᚐLocation(ctx, field.Selections, res)
+	return ec.marshalNPickupDropOff2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐPickupDropOff(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Stop_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopTime_dropOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Stop",
+		Object:     "StopTime",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "latitude":
-				return ec.fieldContext_Location_latitude(ctx, field)
-			case "longitude":
-				return ec.fieldContext_Location_longitude(ctx, field)
-			case "distance":
-				return ec.fieldContext_Location_distance(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+			return nil, errors.New("field of type PickupDropOff does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Stop_routes(ctx context.Context, field graphql.CollectedField, obj *model.Stop) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Stop_routes(ctx, field)
+func (ec *executionContext) _StopTime_pickupOnly(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopTime_pickupOnly(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3931,7 +7326,7 @@ func (ec *executionContext) _Stop_routes(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Stop().Routes(rctx, obj)
+		return ec.resolvers.StopTime().PickupOnly(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3943,46 +7338,26 @@ func (ec *executionContext) _Stop_routes(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.StopRoute)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNStopRoute2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐStopRouteᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Stop_routes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopTime_pickupOnly(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Stop",
+		Object:     "StopTime",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "stop":
-				return ec.fieldContext_StopRoute_stop(ctx, field)
-			case "route":
-				return ec.fieldContext_StopRoute_route(ctx, field)
-			case "direction":
-				return ec.fieldContext_StopRoute_direction(ctx, field)
-			case "headsign":
-				return ec.fieldContext_StopRoute_headsign(ctx, field)
-			case "schedule":
-				return ec.fieldContext_StopRoute_schedule(ctx, field)
-			case "scheduleReaches":
-				return ec.fieldContext_StopRoute_scheduleReaches(ctx, field)
-			case "reaches":
-				return ec.fieldContext_StopRoute_reaches(ctx, field)
-			case "liveMap":
-				return ec.fieldContext_StopRoute_liveMap(ctx, field)
-			case "liveBuses":
-				return ec.fieldContext_StopRoute_liveBuses(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type StopRoute", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopRoute_stop(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopRoute_stop(ctx, field)
+func (ec *executionContext) _StopTime_dropOffOnly(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopTime_dropOffOnly(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3995,7 +7370,7 @@ func (ec *executionContext) _StopRoute_stop(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.StopRoute().Stop(rctx, obj)
+		return ec.resolvers.StopTime().DropOffOnly(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4007,38 +7382,26 @@ func (ec *executionContext) _StopRoute_stop(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Stop)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNStop2stopᚑcheckerᚗcomᚋdbᚋmodelᚐStop(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopRoute_stop(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopTime_dropOffOnly(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopRoute",
+		Object:     "StopTime",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Stop_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Stop_name(ctx, field)
-			case "code":
-				return ec.fieldContext_Stop_code(ctx, field)
-			case "location":
-				return ec.fieldContext_Stop_location(ctx, field)
-			case "routes":
-				return ec.fieldContext_Stop_routes(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Stop", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopRoute_route(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopRoute_route(ctx, field)
+func (ec *executionContext) _StopTime_approximate(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StopTime_approximate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4051,7 +7414,7 @@ func (ec *executionContext) _StopRoute_route(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.StopRoute().Route(rctx, obj)
+		return obj.Approximate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4063,80 +7426,111 @@ func (ec *executionContext) _StopRoute_route(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Route)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNRoute2stopᚑcheckerᚗcomᚋdbᚋmodelᚐRoute(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopRoute_route(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StopTime_approximate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopRoute",
+		Object:     "StopTime",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Route_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Route_name(ctx, field)
-			case "text":
-				return ec.fieldContext_Route_text(ctx, field)
-			case "background":
-				return ec.fieldContext_Route_background(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Route", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopRoute_direction(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopRoute_direction(ctx, field)
+func (ec *executionContext) _Subscription_stopArrivals(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_stopArrivals(ctx, field)
 	if err != nil {
-		return graphql.Null
+		return nil
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ret = nil
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.StopRoute().Direction(rctx, obj)
+		return ec.resolvers.Subscription().StopArrivals(rctx, fc.Args["stopId"].(string), fc.Args["routeId"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan []model.Bus):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNBus2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐBusᚄ(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopRoute_direction(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_stopArrivals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopRoute",
+		Object:     "Subscription",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "headsign":
+				return ec.fieldContext_Bus_headsign(ctx, field)
+			case "arrival":
+				return ec.fieldContext_Bus_arrival(ctx, field)
+			case "lastUpdated":
+				return ec.fieldContext_Bus_lastUpdated(ctx, field)
+			case "lastUpdatedMinutes":
+				return ec.fieldContext_Bus_lastUpdatedMinutes(ctx, field)
+			case "lastUpdatedMessage":
+				return ec.fieldContext_Bus_lastUpdatedMessage(ctx, field)
+			case "distance":
+				return ec.fieldContext_Bus_distance(ctx, field)
+			case "location":
+				return ec.fieldContext_Bus_location(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Bus", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_stopArrivals_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopRoute_headsign(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopRoute_headsign(ctx, field)
+func (ec *executionContext) _Transit_route(ctx context.Context, field graphql.CollectedField, obj *model.Transit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transit_route(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4149,7 +7543,7 @@ func (ec *executionContext) _StopRoute_headsign(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Headsign, nil
+		return ec.resolvers.Transit().Route(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4161,26 +7555,50 @@ func (ec *executionContext) _StopRoute_headsign(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.Route)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNRoute2stopᚑcheckerᚗcomᚋdbᚋmodelᚐRoute(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopRoute_headsign(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Transit_route(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopRoute",
+		Object:     "Transit",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Route_id(ctx, field)
+			case "agency":
+				return ec.fieldContext_Route_agency(ctx, field)
+			case "name":
+				return ec.fieldContext_Route_name(ctx, field)
+			case "longName":
+				return ec.fieldContext_Route_longName(ctx, field)
+			case "description":
+				return ec.fieldContext_Route_description(ctx, field)
+			case "url":
+				return ec.fieldContext_Route_url(ctx, field)
+			case "mode":
+				return ec.fieldContext_Route_mode(ctx, field)
+			case "text":
+				return ec.fieldContext_Route_text(ctx, field)
+			case "background":
+				return ec.fieldContext_Route_background(ctx, field)
+			case "vehicles":
+				return ec.fieldContext_Route_vehicles(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Route_alerts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Route", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopRoute_schedule(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopRoute_schedule(ctx, field)
+func (ec *executionContext) _Transit_trip(ctx context.Context, field graphql.CollectedField, obj *model.Transit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transit_trip(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4193,7 +7611,7 @@ func (ec *executionContext) _StopRoute_schedule(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.StopRoute().Schedule(rctx, obj)
+		return ec.resolvers.Transit().Trip(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4205,32 +7623,54 @@ func (ec *executionContext) _StopRoute_schedule(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(repository.Schedule)
+	res := resTmp.(model.Trip)
 	fc.Result = res
-	return ec.marshalNSchedule2stopᚑcheckerᚗcomᚋdbᚋrepositoryᚐSchedule(ctx, field.Selections, res)
+	return ec.marshalNTrip2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTrip(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopRoute_schedule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Transit_trip(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopRoute",
+		Object:     "Transit",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "next":
-				return ec.fieldContext_Schedule_next(ctx, field)
-			case "on":
-				return ec.fieldContext_Schedule_on(ctx, field)
+			case "id":
+				return ec.fieldContext_Trip_id(ctx, field)
+			case "route":
+				return ec.fieldContext_Trip_route(ctx, field)
+			case "stoptimes":
+				return ec.fieldContext_Trip_stoptimes(ctx, field)
+			case "shape":
+				return ec.fieldContext_Trip_shape(ctx, field)
+			case "service":
+				return ec.fieldContext_Trip_service(ctx, field)
+			case "direction":
+				return ec.fieldContext_Trip_direction(ctx, field)
+			case "headsign":
+				return ec.fieldContext_Trip_headsign(ctx, field)
+			case "name":
+				return ec.fieldContext_Trip_name(ctx, field)
+			case "headway":
+				return ec.fieldContext_Trip_headway(ctx, field)
+			case "wheelchair":
+				return ec.fieldContext_Trip_wheelchair(ctx, field)
+			case "bikes":
+				return ec.fieldContext_Trip_bikes(ctx, field)
+			case "predictions":
+				return ec.fieldContext_Trip_predictions(ctx, field)
+			case "vehicle":
+				return ec.fieldContext_Trip_vehicle(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Schedule", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Trip", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopRoute_scheduleReaches(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopRoute_scheduleReaches(ctx, field)
+func (ec *executionContext) _Transit_departure(ctx context.Context, field graphql.CollectedField, obj *model.Transit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transit_departure(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4243,52 +7683,38 @@ func (ec *executionContext) _StopRoute_scheduleReaches(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.StopRoute().ScheduleReaches(rctx, obj, fc.Args["destination"].(string))
+		return ec.resolvers.Transit().Departure(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(repository.Schedule)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOSchedule2stopᚑcheckerᚗcomᚋdbᚋrepositoryᚐSchedule(ctx, field.Selections, res)
+	return ec.marshalNDatetime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopRoute_scheduleReaches(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Transit_departure(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopRoute",
+		Object:     "Transit",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "next":
-				return ec.fieldContext_Schedule_next(ctx, field)
-			case "on":
-				return ec.fieldContext_Schedule_on(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Schedule", field.Name)
+			return nil, errors.New("field of type Datetime does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_StopRoute_scheduleReaches_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopRoute_reaches(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopRoute_reaches(ctx, field)
+func (ec *executionContext) _Transit_duration(ctx context.Context, field graphql.CollectedField, obj *model.Transit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transit_duration(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4301,7 +7727,7 @@ func (ec *executionContext) _StopRoute_reaches(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.StopRoute().Reaches(rctx, obj, fc.Args["forward"].(bool))
+		return ec.resolvers.Transit().Duration(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4313,49 +7739,26 @@ func (ec *executionContext) _StopRoute_reaches(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.Stop)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNStop2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐStopᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopRoute_reaches(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Transit_duration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopRoute",
+		Object:     "Transit",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Stop_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Stop_name(ctx, field)
-			case "code":
-				return ec.fieldContext_Stop_code(ctx, field)
-			case "location":
-				return ec.fieldContext_Stop_location(ctx, field)
-			case "routes":
-				return ec.fieldContext_Stop_routes(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Stop", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_StopRoute_reaches_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopRoute_liveMap(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopRoute_liveMap(ctx, field)
+func (ec *executionContext) _Transit_wait(ctx context.Context, field graphql.CollectedField, obj *model.Transit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Transit_wait(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4368,35 +7771,38 @@ func (ec *executionContext) _StopRoute_liveMap(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.StopRoute().LiveMap(rctx, obj)
+		return ec.resolvers.Transit().Wait(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopRoute_liveMap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Transit_wait(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopRoute",
+		Object:     "Transit",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopRoute_liveBuses(ctx context.Context, field graphql.CollectedField, obj *model.StopRoute) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopRoute_liveBuses(ctx, field)
+func (ec *executionContext) _TravelSchedule_origin(ctx context.Context, field graphql.CollectedField, obj *model.TravelSchedule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelSchedule_origin(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4409,7 +7815,7 @@ func (ec *executionContext) _StopRoute_liveBuses(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.StopRoute().LiveBuses(rctx, obj)
+		return obj.Origin(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4421,42 +7827,34 @@ func (ec *executionContext) _StopRoute_liveBuses(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.Bus)
+	res := resTmp.(model.TravelScheduleNode)
 	fc.Result = res
-	return ec.marshalNBus2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐBusᚄ(ctx, field.Selections, res)
+	return ec.marshalNTravelScheduleNode2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTravelScheduleNode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopRoute_liveBuses(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelSchedule_origin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopRoute",
+		Object:     "TravelSchedule",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "headsign":
-				return ec.fieldContext_Bus_headsign(ctx, field)
-			case "arrival":
-				return ec.fieldContext_Bus_arrival(ctx, field)
-			case "lastUpdated":
-				return ec.fieldContext_Bus_lastUpdated(ctx, field)
-			case "lastUpdatedMinutes":
-				return ec.fieldContext_Bus_lastUpdatedMinutes(ctx, field)
-			case "lastUpdatedMessage":
-				return ec.fieldContext_Bus_lastUpdatedMessage(ctx, field)
-			case "distance":
-				return ec.fieldContext_Bus_distance(ctx, field)
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
 			case "location":
-				return ec.fieldContext_Bus_location(ctx, field)
+				return ec.fieldContext_TravelScheduleNode_location(ctx, field)
+			case "arrival":
+				return ec.fieldContext_TravelScheduleNode_arrival(ctx, field)
+			case "stop":
+				return ec.fieldContext_TravelScheduleNode_stop(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Bus", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TravelScheduleNode", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopSearchPayload_page(ctx context.Context, field graphql.CollectedField, obj *StopSearchPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopSearchPayload_page(ctx, field)
+func (ec *executionContext) _TravelSchedule_destination(ctx context.Context, field graphql.CollectedField, obj *model.TravelSchedule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelSchedule_destination(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4469,7 +7867,7 @@ func (ec *executionContext) _StopSearchPayload_page(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
+		return obj.Destination(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4481,32 +7879,34 @@ func (ec *executionContext) _StopSearchPayload_page(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*PageInfo)
+	res := resTmp.(model.TravelScheduleNode)
 	fc.Result = res
-	return ec.marshalNPageInfo2ᚖstopᚑcheckerᚗcomᚋapplicationᚋschemaᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNTravelScheduleNode2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTravelScheduleNode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopSearchPayload_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelSchedule_destination(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopSearchPayload",
+		Object:     "TravelSchedule",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "cursor":
-				return ec.fieldContext_PageInfo_cursor(ctx, field)
-			case "remaining":
-				return ec.fieldContext_PageInfo_remaining(ctx, field)
+			case "location":
+				return ec.fieldContext_TravelScheduleNode_location(ctx, field)
+			case "arrival":
+				return ec.fieldContext_TravelScheduleNode_arrival(ctx, field)
+			case "stop":
+				return ec.fieldContext_TravelScheduleNode_stop(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TravelScheduleNode", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopSearchPayload_results(ctx context.Context, field graphql.CollectedField, obj *StopSearchPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopSearchPayload_results(ctx, field)
+func (ec *executionContext) _TravelSchedule_duration(ctx context.Context, field graphql.CollectedField, obj *model.TravelSchedule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelSchedule_duration(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4519,7 +7919,7 @@ func (ec *executionContext) _StopSearchPayload_results(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Results, nil
+		return ec.resolvers.TravelSchedule().Duration(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4531,38 +7931,26 @@ func (ec *executionContext) _StopSearchPayload_results(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.Stop)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNStop2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐStopᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopSearchPayload_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelSchedule_duration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopSearchPayload",
+		Object:     "TravelSchedule",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Stop_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Stop_name(ctx, field)
-			case "code":
-				return ec.fieldContext_Stop_code(ctx, field)
-			case "location":
-				return ec.fieldContext_Stop_location(ctx, field)
-			case "routes":
-				return ec.fieldContext_Stop_routes(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Stop", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopTime_id(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopTime_id(ctx, field)
+func (ec *executionContext) _TravelSchedule_legs(ctx context.Context, field graphql.CollectedField, obj *model.TravelSchedule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelSchedule_legs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4575,7 +7963,7 @@ func (ec *executionContext) _StopTime_id(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID(), nil
+		return obj.Legs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4587,26 +7975,40 @@ func (ec *executionContext) _StopTime_id(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]model.TravelScheduleLeg)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNTravelScheduleLeg2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐTravelScheduleLegᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopTime_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelSchedule_legs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopTime",
+		Object:     "TravelSchedule",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "origin":
+				return ec.fieldContext_TravelScheduleLeg_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_TravelScheduleLeg_destination(ctx, field)
+			case "duration":
+				return ec.fieldContext_TravelScheduleLeg_duration(ctx, field)
+			case "transit":
+				return ec.fieldContext_TravelScheduleLeg_transit(ctx, field)
+			case "walk":
+				return ec.fieldContext_TravelScheduleLeg_walk(ctx, field)
+			case "alerts":
+				return ec.fieldContext_TravelScheduleLeg_alerts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TravelScheduleLeg", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopTime_stop(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopTime_stop(ctx, field)
+func (ec *executionContext) _TravelScheduleLeg_origin(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleLeg) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelScheduleLeg_origin(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4619,7 +8021,7 @@ func (ec *executionContext) _StopTime_stop(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.StopTime().Stop(rctx, obj)
+		return obj.Origin, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4631,38 +8033,34 @@ func (ec *executionContext) _StopTime_stop(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Stop)
+	res := resTmp.(model.TravelScheduleNode)
 	fc.Result = res
-	return ec.marshalNStop2stopᚑcheckerᚗcomᚋdbᚋmodelᚐStop(ctx, field.Selections, res)
+	return ec.marshalNTravelScheduleNode2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTravelScheduleNode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopTime_stop(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelScheduleLeg_origin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopTime",
+		Object:     "TravelScheduleLeg",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Stop_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Stop_name(ctx, field)
-			case "code":
-				return ec.fieldContext_Stop_code(ctx, field)
 			case "location":
-				return ec.fieldContext_Stop_location(ctx, field)
-			case "routes":
-				return ec.fieldContext_Stop_routes(ctx, field)
+				return ec.fieldContext_TravelScheduleNode_location(ctx, field)
+			case "arrival":
+				return ec.fieldContext_TravelScheduleNode_arrival(ctx, field)
+			case "stop":
+				return ec.fieldContext_TravelScheduleNode_stop(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Stop", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TravelScheduleNode", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopTime_trip(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopTime_trip(ctx, field)
+func (ec *executionContext) _TravelScheduleLeg_destination(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleLeg) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelScheduleLeg_destination(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4675,7 +8073,7 @@ func (ec *executionContext) _StopTime_trip(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.StopTime().Trip(rctx, obj)
+		return obj.Destination, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4687,42 +8085,34 @@ func (ec *executionContext) _StopTime_trip(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Trip)
+	res := resTmp.(model.TravelScheduleNode)
 	fc.Result = res
-	return ec.marshalNTrip2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTrip(ctx, field.Selections, res)
+	return ec.marshalNTravelScheduleNode2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTravelScheduleNode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopTime_trip(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelScheduleLeg_destination(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopTime",
+		Object:     "TravelScheduleLeg",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Trip_id(ctx, field)
-			case "route":
-				return ec.fieldContext_Trip_route(ctx, field)
-			case "stoptimes":
-				return ec.fieldContext_Trip_stoptimes(ctx, field)
-			case "shape":
-				return ec.fieldContext_Trip_shape(ctx, field)
-			case "service":
-				return ec.fieldContext_Trip_service(ctx, field)
-			case "direction":
-				return ec.fieldContext_Trip_direction(ctx, field)
-			case "headsign":
-				return ec.fieldContext_Trip_headsign(ctx, field)
+			case "location":
+				return ec.fieldContext_TravelScheduleNode_location(ctx, field)
+			case "arrival":
+				return ec.fieldContext_TravelScheduleNode_arrival(ctx, field)
+			case "stop":
+				return ec.fieldContext_TravelScheduleNode_stop(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Trip", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TravelScheduleNode", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopTime_time(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopTime_time(ctx, field)
+func (ec *executionContext) _TravelScheduleLeg_duration(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleLeg) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelScheduleLeg_duration(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4735,7 +8125,7 @@ func (ec *executionContext) _StopTime_time(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Time, nil
+		return ec.resolvers.TravelScheduleLeg().Duration(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4747,26 +8137,26 @@ func (ec *executionContext) _StopTime_time(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTime2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopTime_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelScheduleLeg_duration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopTime",
+		Object:     "TravelScheduleLeg",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopTime_sequence(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopTime_sequence(ctx, field)
+func (ec *executionContext) _TravelScheduleLeg_transit(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleLeg) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelScheduleLeg_transit(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4779,38 +8169,47 @@ func (ec *executionContext) _StopTime_sequence(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Sequence, nil
+		return obj.Transit, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.Transit)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOTransit2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐTransit(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopTime_sequence(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelScheduleLeg_transit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopTime",
+		Object:     "TravelScheduleLeg",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "route":
+				return ec.fieldContext_Transit_route(ctx, field)
+			case "trip":
+				return ec.fieldContext_Transit_trip(ctx, field)
+			case "departure":
+				return ec.fieldContext_Transit_departure(ctx, field)
+			case "duration":
+				return ec.fieldContext_Transit_duration(ctx, field)
+			case "wait":
+				return ec.fieldContext_Transit_wait(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Transit", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StopTime_overflow(ctx context.Context, field graphql.CollectedField, obj *model.StopTime) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StopTime_overflow(ctx, field)
+func (ec *executionContext) _TravelScheduleLeg_walk(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleLeg) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelScheduleLeg_walk(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4823,38 +8222,41 @@ func (ec *executionContext) _StopTime_overflow(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Overflow, nil
+		return obj.Walk, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.Path)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOPath2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐPath(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StopTime_overflow(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelScheduleLeg_walk(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StopTime",
+		Object:     "TravelScheduleLeg",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "distance":
+				return ec.fieldContext_Path_distance(ctx, field)
+			case "path":
+				return ec.fieldContext_Path_path(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Path", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transit_route(ctx context.Context, field graphql.CollectedField, obj *model.Transit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transit_route(ctx, field)
+func (ec *executionContext) _TravelScheduleLeg_alerts(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleLeg) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelScheduleLeg_alerts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4867,7 +8269,7 @@ func (ec *executionContext) _Transit_route(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Transit().Route(rctx, obj)
+		return ec.resolvers.TravelScheduleLeg().Alerts(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4879,36 +8281,44 @@ func (ec *executionContext) _Transit_route(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Route)
+	res := resTmp.([]model.Alert)
 	fc.Result = res
-	return ec.marshalNRoute2stopᚑcheckerᚗcomᚋdbᚋmodelᚐRoute(ctx, field.Selections, res)
+	return ec.marshalNAlert2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐAlertᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transit_route(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelScheduleLeg_alerts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transit",
+		Object:     "TravelScheduleLeg",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Route_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Route_name(ctx, field)
-			case "text":
-				return ec.fieldContext_Route_text(ctx, field)
-			case "background":
-				return ec.fieldContext_Route_background(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Route", field.Name)
+				return ec.fieldContext_Alert_id(ctx, field)
+			case "header":
+				return ec.fieldContext_Alert_header(ctx, field)
+			case "description":
+				return ec.fieldContext_Alert_description(ctx, field)
+			case "url":
+				return ec.fieldContext_Alert_url(ctx, field)
+			case "cause":
+				return ec.fieldContext_Alert_cause(ctx, field)
+			case "effect":
+				return ec.fieldContext_Alert_effect(ctx, field)
+			case "active":
+				return ec.fieldContext_Alert_active(ctx, field)
+			case "periods":
+				return ec.fieldContext_Alert_periods(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transit_trip(ctx context.Context, field graphql.CollectedField, obj *model.Transit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transit_trip(ctx, field)
+func (ec *executionContext) _TravelScheduleNode_location(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelScheduleNode_location(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4921,7 +8331,7 @@ func (ec *executionContext) _Transit_trip(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Transit().Trip(rctx, obj)
+		return obj.Location, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4933,42 +8343,34 @@ func (ec *executionContext) _Transit_trip(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Trip)
+	res := resTmp.(model.Location)
 	fc.Result = res
-	return ec.marshalNTrip2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTrip(ctx, field.Selections, res)
+	return ec.marshalNLocation2stopᚑcheckerᚗcomᚋdbᚋmodelᚐLocation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transit_trip(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelScheduleNode_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transit",
+		Object:     "TravelScheduleNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Trip_id(ctx, field)
-			case "route":
-				return ec.fieldContext_Trip_route(ctx, field)
-			case "stoptimes":
-				return ec.fieldContext_Trip_stoptimes(ctx, field)
-			case "shape":
-				return ec.fieldContext_Trip_shape(ctx, field)
-			case "service":
-				return ec.fieldContext_Trip_service(ctx, field)
-			case "direction":
-				return ec.fieldContext_Trip_direction(ctx, field)
-			case "headsign":
-				return ec.fieldContext_Trip_headsign(ctx, field)
+			case "latitude":
+				return ec.fieldContext_Location_latitude(ctx, field)
+			case "longitude":
+				return ec.fieldContext_Location_longitude(ctx, field)
+			case "distance":
+				return ec.fieldContext_Location_distance(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Trip", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transit_departure(ctx context.Context, field graphql.CollectedField, obj *model.Transit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transit_departure(ctx, field)
+func (ec *executionContext) _TravelScheduleNode_arrival(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelScheduleNode_arrival(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4981,7 +8383,7 @@ func (ec *executionContext) _Transit_departure(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Transit().Departure(rctx, obj)
+		return obj.Arrival, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4998,12 +8400,12 @@ func (ec *executionContext) _Transit_departure(ctx context.Context, field graphq
 	return ec.marshalNDatetime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transit_departure(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelScheduleNode_arrival(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transit",
+		Object:     "TravelScheduleNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type Datetime does not have child fields")
 		},
@@ -5011,8 +8413,8 @@ func (ec *executionContext) fieldContext_Transit_departure(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Transit_duration(ctx context.Context, field graphql.CollectedField, obj *model.Transit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transit_duration(ctx, field)
+func (ec *executionContext) _TravelScheduleNode_stop(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelScheduleNode_stop(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5025,38 +8427,57 @@ func (ec *executionContext) _Transit_duration(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Transit().Duration(rctx, obj)
+		return ec.resolvers.TravelScheduleNode().Stop(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.Stop)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOStop2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐStop(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transit_duration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelScheduleNode_stop(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transit",
+		Object:     "TravelScheduleNode",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Stop_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Stop_name(ctx, field)
+			case "code":
+				return ec.fieldContext_Stop_code(ctx, field)
+			case "agency":
+				return ec.fieldContext_Stop_agency(ctx, field)
+			case "location":
+				return ec.fieldContext_Stop_location(ctx, field)
+			case "routes":
+				return ec.fieldContext_Stop_routes(ctx, field)
+			case "parent":
+				return ec.fieldContext_Stop_parent(ctx, field)
+			case "platforms":
+				return ec.fieldContext_Stop_platforms(ctx, field)
+			case "wheelchair":
+				return ec.fieldContext_Stop_wheelchair(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Stop_alerts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Stop", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transit_wait(ctx context.Context, field graphql.CollectedField, obj *model.Transit) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Transit_wait(ctx, field)
+func (ec *executionContext) _TravelSchedulePayload_schedule(ctx context.Context, field graphql.CollectedField, obj *TravelSchedulePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelSchedulePayload_schedule(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5069,38 +8490,45 @@ func (ec *executionContext) _Transit_wait(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Transit().Wait(rctx, obj)
+		return obj.Schedule, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.TravelSchedule)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOTravelSchedule2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐTravelSchedule(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Transit_wait(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelSchedulePayload_schedule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transit",
+		Object:     "TravelSchedulePayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "origin":
+				return ec.fieldContext_TravelSchedule_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_TravelSchedule_destination(ctx, field)
+			case "duration":
+				return ec.fieldContext_TravelSchedule_duration(ctx, field)
+			case "legs":
+				return ec.fieldContext_TravelSchedule_legs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TravelSchedule", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TravelSchedule_origin(ctx context.Context, field graphql.CollectedField, obj *model.TravelSchedule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TravelSchedule_origin(ctx, field)
+func (ec *executionContext) _TravelSchedulePayload_error(ctx context.Context, field graphql.CollectedField, obj *TravelSchedulePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TravelSchedulePayload_error(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5113,46 +8541,35 @@ func (ec *executionContext) _TravelSchedule_origin(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Origin(), nil
+		return obj.Error, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.TravelScheduleNode)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNTravelScheduleNode2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTravelScheduleNode(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TravelSchedule_origin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TravelSchedulePayload_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TravelSchedule",
+		Object:     "TravelSchedulePayload",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "location":
-				return ec.fieldContext_TravelScheduleNode_location(ctx, field)
-			case "arrival":
-				return ec.fieldContext_TravelScheduleNode_arrival(ctx, field)
-			case "stop":
-				return ec.fieldContext_TravelScheduleNode_stop(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TravelScheduleNode", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TravelSchedule_destination(ctx context.Context, field graphql.CollectedField, obj *model.TravelSchedule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TravelSchedule_destination(ctx, field)
+func (ec *executionContext) _Trip_id(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trip_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5165,7 +8582,7 @@ func (ec *executionContext) _TravelSchedule_destination(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Destination(), nil
+		return ec.resolvers.Trip().ID(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5177,34 +8594,26 @@ func (ec *executionContext) _TravelSchedule_destination(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.TravelScheduleNode)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTravelScheduleNode2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTravelScheduleNode(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TravelSchedule_destination(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trip_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TravelSchedule",
+		Object:     "Trip",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "location":
-				return ec.fieldContext_TravelScheduleNode_location(ctx, field)
-			case "arrival":
-				return ec.fieldContext_TravelScheduleNode_arrival(ctx, field)
-			case "stop":
-				return ec.fieldContext_TravelScheduleNode_stop(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TravelScheduleNode", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TravelSchedule_duration(ctx context.Context, field graphql.CollectedField, obj *model.TravelSchedule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TravelSchedule_duration(ctx, field)
+func (ec *executionContext) _Trip_route(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trip_route(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5217,7 +8626,7 @@ func (ec *executionContext) _TravelSchedule_duration(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.TravelSchedule().Duration(rctx, obj)
+		return ec.resolvers.Trip().Route(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5229,26 +8638,50 @@ func (ec *executionContext) _TravelSchedule_duration(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(model.Route)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNRoute2stopᚑcheckerᚗcomᚋdbᚋmodelᚐRoute(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TravelSchedule_duration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trip_route(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TravelSchedule",
+		Object:     "Trip",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Route_id(ctx, field)
+			case "agency":
+				return ec.fieldContext_Route_agency(ctx, field)
+			case "name":
+				return ec.fieldContext_Route_name(ctx, field)
+			case "longName":
+				return ec.fieldContext_Route_longName(ctx, field)
+			case "description":
+				return ec.fieldContext_Route_description(ctx, field)
+			case "url":
+				return ec.fieldContext_Route_url(ctx, field)
+			case "mode":
+				return ec.fieldContext_Route_mode(ctx, field)
+			case "text":
+				return ec.fieldContext_Route_text(ctx, field)
+			case "background":
+				return ec.fieldContext_Route_background(ctx, field)
+			case "vehicles":
+				return ec.fieldContext_Route_vehicles(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Route_alerts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Route", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TravelSchedule_legs(ctx context.Context, field graphql.CollectedField, obj *model.TravelSchedule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TravelSchedule_legs(ctx, field)
+func (ec *executionContext) _Trip_stoptimes(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trip_stoptimes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5261,7 +8694,7 @@ func (ec *executionContext) _TravelSchedule_legs(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Legs, nil
+		return ec.resolvers.Trip().Stoptimes(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5273,38 +8706,56 @@ func (ec *executionContext) _TravelSchedule_legs(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.TravelScheduleLeg)
+	res := resTmp.([]model.StopTime)
 	fc.Result = res
-	return ec.marshalNTravelScheduleLeg2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐTravelScheduleLegᚄ(ctx, field.Selections, res)
+	return ec.marshalNStopTime2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐStopTimeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TravelSchedule_legs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trip_stoptimes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TravelSchedule",
+		Object:     "Trip",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "origin":
-				return ec.fieldContext_TravelScheduleLeg_origin(ctx, field)
-			case "destination":
-				return ec.fieldContext_TravelScheduleLeg_destination(ctx, field)
-			case "duration":
-				return ec.fieldContext_TravelScheduleLeg_duration(ctx, field)
-			case "transit":
-				return ec.fieldContext_TravelScheduleLeg_transit(ctx, field)
-			case "walk":
-				return ec.fieldContext_TravelScheduleLeg_walk(ctx, field)
+			case "id":
+				return ec.fieldContext_StopTime_id(ctx, field)
+			case "stop":
+				return ec.fieldContext_StopTime_stop(ctx, field)
+			case "trip":
+				return ec.fieldContext_StopTime_trip(ctx, field)
+			case "arrival":
+				return ec.fieldContext_StopTime_arrival(ctx, field)
+			case "departure":
+				return ec.fieldContext_StopTime_departure(ctx, field)
+			case "time":
+				return ec.fieldContext_StopTime_time(ctx, field)
+			case "sequence":
+				return ec.fieldContext_StopTime_sequence(ctx, field)
+			case "overflow":
+				return ec.fieldContext_StopTime_overflow(ctx, field)
+			case "headsign":
+				return ec.fieldContext_StopTime_headsign(ctx, field)
+			case "pickup":
+				return ec.fieldContext_StopTime_pickup(ctx, field)
+			case "dropOff":
+				return ec.fieldContext_StopTime_dropOff(ctx, field)
+			case "pickupOnly":
+				return ec.fieldContext_StopTime_pickupOnly(ctx, field)
+			case "dropOffOnly":
+				return ec.fieldContext_StopTime_dropOffOnly(ctx, field)
+			case "approximate":
+				return ec.fieldContext_StopTime_approximate(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TravelScheduleLeg", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type StopTime", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TravelScheduleLeg_origin(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleLeg) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TravelScheduleLeg_origin(ctx, field)
+func (ec *executionContext) _Trip_shape(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trip_shape(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5317,7 +8768,7 @@ func (ec *executionContext) _TravelScheduleLeg_origin(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Origin, nil
+		return ec.resolvers.Trip().Shape(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5329,34 +8780,34 @@ func (ec *executionContext) _TravelScheduleLeg_origin(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.TravelScheduleNode)
+	res := resTmp.([]model.Location)
 	fc.Result = res
-	return ec.marshalNTravelScheduleNode2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTravelScheduleNode(ctx, field.Selections, res)
+	return ec.marshalNLocation2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐLocationᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TravelScheduleLeg_origin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trip_shape(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TravelScheduleLeg",
+		Object:     "Trip",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "location":
-				return ec.fieldContext_TravelScheduleNode_location(ctx, field)
-			case "arrival":
-				return ec.fieldContext_TravelScheduleNode_arrival(ctx, field)
-			case "stop":
-				return ec.fieldContext_TravelScheduleNode_stop(ctx, field)
+			case "latitude":
+				return ec.fieldContext_Location_latitude(ctx, field)
+			case "longitude":
+				return ec.fieldContext_Location_longitude(ctx, field)
+			case "distance":
+				return ec.fieldContext_Location_distance(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TravelScheduleNode", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TravelScheduleLeg_destination(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleLeg) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TravelScheduleLeg_destination(ctx, field)
+func (ec *executionContext) _Trip_service(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trip_service(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5369,7 +8820,7 @@ func (ec *executionContext) _TravelScheduleLeg_destination(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Destination, nil
+		return ec.resolvers.Trip().Service(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5381,34 +8832,48 @@ func (ec *executionContext) _TravelScheduleLeg_destination(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.TravelScheduleNode)
+	res := resTmp.(model.Service)
 	fc.Result = res
-	return ec.marshalNTravelScheduleNode2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTravelScheduleNode(ctx, field.Selections, res)
+	return ec.marshalNService2stopᚑcheckerᚗcomᚋdbᚋmodelᚐService(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TravelScheduleLeg_destination(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trip_service(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TravelScheduleLeg",
+		Object:     "Trip",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "location":
-				return ec.fieldContext_TravelScheduleNode_location(ctx, field)
-			case "arrival":
-				return ec.fieldContext_TravelScheduleNode_arrival(ctx, field)
-			case "stop":
-				return ec.fieldContext_TravelScheduleNode_stop(ctx, field)
+			case "sunday":
+				return ec.fieldContext_Service_sunday(ctx, field)
+			case "monday":
+				return ec.fieldContext_Service_monday(ctx, field)
+			case "tuesday":
+				return ec.fieldContext_Service_tuesday(ctx, field)
+			case "wednesday":
+				return ec.fieldContext_Service_wednesday(ctx, field)
+			case "thursday":
+				return ec.fieldContext_Service_thursday(ctx, field)
+			case "friday":
+				return ec.fieldContext_Service_friday(ctx, field)
+			case "saturday":
+				return ec.fieldContext_Service_saturday(ctx, field)
+			case "start":
+				return ec.fieldContext_Service_start(ctx, field)
+			case "end":
+				return ec.fieldContext_Service_end(ctx, field)
+			case "exceptions":
+				return ec.fieldContext_Service_exceptions(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TravelScheduleNode", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Service", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TravelScheduleLeg_duration(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleLeg) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TravelScheduleLeg_duration(ctx, field)
+func (ec *executionContext) _Trip_direction(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trip_direction(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5421,7 +8886,7 @@ func (ec *executionContext) _TravelScheduleLeg_duration(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.TravelScheduleLeg().Duration(rctx, obj)
+		return ec.resolvers.Trip().Direction(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5433,26 +8898,26 @@ func (ec *executionContext) _TravelScheduleLeg_duration(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TravelScheduleLeg_duration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trip_direction(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TravelScheduleLeg",
+		Object:     "Trip",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TravelScheduleLeg_transit(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleLeg) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TravelScheduleLeg_transit(ctx, field)
+func (ec *executionContext) _Trip_headsign(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trip_headsign(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5465,47 +8930,38 @@ func (ec *executionContext) _TravelScheduleLeg_transit(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Transit, nil
+		return obj.Headsign, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Transit)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOTransit2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐTransit(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TravelScheduleLeg_transit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trip_headsign(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TravelScheduleLeg",
+		Object:     "Trip",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "route":
-				return ec.fieldContext_Transit_route(ctx, field)
-			case "trip":
-				return ec.fieldContext_Transit_trip(ctx, field)
-			case "departure":
-				return ec.fieldContext_Transit_departure(ctx, field)
-			case "duration":
-				return ec.fieldContext_Transit_duration(ctx, field)
-			case "wait":
-				return ec.fieldContext_Transit_wait(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Transit", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TravelScheduleLeg_walk(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleLeg) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TravelScheduleLeg_walk(ctx, field)
+func (ec *executionContext) _Trip_name(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trip_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5518,41 +8974,38 @@ func (ec *executionContext) _TravelScheduleLeg_walk(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Walk, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Path)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOPath2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐPath(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TravelScheduleLeg_walk(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trip_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TravelScheduleLeg",
+		Object:     "Trip",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "distance":
-				return ec.fieldContext_Path_distance(ctx, field)
-			case "path":
-				return ec.fieldContext_Path_path(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Path", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TravelScheduleNode_location(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TravelScheduleNode_location(ctx, field)
+func (ec *executionContext) _Trip_headway(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trip_headway(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5565,46 +9018,35 @@ func (ec *executionContext) _TravelScheduleNode_location(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Location, nil
+		return ec.resolvers.Trip().Headway(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Location)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNLocation2stopᚑcheckerᚗcomᚋdbᚋmodelᚐLocation(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TravelScheduleNode_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trip_headway(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TravelScheduleNode",
+		Object:     "Trip",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "latitude":
-				return ec.fieldContext_Location_latitude(ctx, field)
-			case "longitude":
-				return ec.fieldContext_Location_longitude(ctx, field)
-			case "distance":
-				return ec.fieldContext_Location_distance(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TravelScheduleNode_arrival(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TravelScheduleNode_arrival(ctx, field)
+func (ec *executionContext) _Trip_wheelchair(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trip_wheelchair(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5617,7 +9059,7 @@ func (ec *executionContext) _TravelScheduleNode_arrival(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Arrival, nil
+		return ec.resolvers.Trip().Wheelchair(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5629,26 +9071,26 @@ func (ec *executionContext) _TravelScheduleNode_arrival(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(Accessibility)
 	fc.Result = res
-	return ec.marshalNDatetime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNAccessibility2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐAccessibility(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TravelScheduleNode_arrival(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trip_wheelchair(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TravelScheduleNode",
+		Object:     "Trip",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Datetime does not have child fields")
+			return nil, errors.New("field of type Accessibility does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TravelScheduleNode_stop(ctx context.Context, field graphql.CollectedField, obj *model.TravelScheduleNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TravelScheduleNode_stop(ctx, field)
+func (ec *executionContext) _Trip_bikes(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trip_bikes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5661,47 +9103,38 @@ func (ec *executionContext) _TravelScheduleNode_stop(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.TravelScheduleNode().Stop(rctx, obj)
+		return ec.resolvers.Trip().Bikes(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Stop)
+	res := resTmp.(Accessibility)
 	fc.Result = res
-	return ec.marshalOStop2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐStop(ctx, field.Selections, res)
+	return ec.marshalNAccessibility2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐAccessibility(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TravelScheduleNode_stop(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trip_bikes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TravelScheduleNode",
+		Object:     "Trip",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Stop_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Stop_name(ctx, field)
-			case "code":
-				return ec.fieldContext_Stop_code(ctx, field)
-			case "location":
-				return ec.fieldContext_Stop_location(ctx, field)
-			case "routes":
-				return ec.fieldContext_Stop_routes(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Stop", field.Name)
+			return nil, errors.New("field of type Accessibility does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TravelSchedulePayload_schedule(ctx context.Context, field graphql.CollectedField, obj *TravelSchedulePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TravelSchedulePayload_schedule(ctx, field)
+func (ec *executionContext) _Trip_predictions(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trip_predictions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5714,7 +9147,7 @@ func (ec *executionContext) _TravelSchedulePayload_schedule(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Schedule, nil
+		return ec.resolvers.Trip().Predictions(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5723,36 +9156,38 @@ func (ec *executionContext) _TravelSchedulePayload_schedule(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.TravelSchedule)
+	res := resTmp.([]model.Prediction)
 	fc.Result = res
-	return ec.marshalOTravelSchedule2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐTravelSchedule(ctx, field.Selections, res)
+	return ec.marshalOPrediction2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐPredictionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TravelSchedulePayload_schedule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trip_predictions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TravelSchedulePayload",
+		Object:     "Trip",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "origin":
-				return ec.fieldContext_TravelSchedule_origin(ctx, field)
-			case "destination":
-				return ec.fieldContext_TravelSchedule_destination(ctx, field)
-			case "duration":
-				return ec.fieldContext_TravelSchedule_duration(ctx, field)
-			case "legs":
-				return ec.fieldContext_TravelSchedule_legs(ctx, field)
+			case "stopTime":
+				return ec.fieldContext_Prediction_stopTime(ctx, field)
+			case "arrival":
+				return ec.fieldContext_Prediction_arrival(ctx, field)
+			case "departure":
+				return ec.fieldContext_Prediction_departure(ctx, field)
+			case "delay":
+				return ec.fieldContext_Prediction_delay(ctx, field)
+			case "skipped":
+				return ec.fieldContext_Prediction_skipped(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TravelSchedule", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Prediction", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TravelSchedulePayload_error(ctx context.Context, field graphql.CollectedField, obj *TravelSchedulePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TravelSchedulePayload_error(ctx, field)
+func (ec *executionContext) _Trip_vehicle(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trip_vehicle(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5765,7 +9200,7 @@ func (ec *executionContext) _TravelSchedulePayload_error(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Error, nil
+		return ec.resolvers.Trip().Vehicle(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5774,26 +9209,42 @@ func (ec *executionContext) _TravelSchedulePayload_error(ctx context.Context, fi
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.Vehicle)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOVehicle2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐVehicle(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TravelSchedulePayload_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trip_vehicle(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TravelSchedulePayload",
+		Object:     "Trip",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Vehicle_id(ctx, field)
+			case "label":
+				return ec.fieldContext_Vehicle_label(ctx, field)
+			case "trip":
+				return ec.fieldContext_Vehicle_trip(ctx, field)
+			case "location":
+				return ec.fieldContext_Vehicle_location(ctx, field)
+			case "bearing":
+				return ec.fieldContext_Vehicle_bearing(ctx, field)
+			case "speed":
+				return ec.fieldContext_Vehicle_speed(ctx, field)
+			case "lastUpdated":
+				return ec.fieldContext_Vehicle_lastUpdated(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Vehicle", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Trip_id(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Trip_id(ctx, field)
+func (ec *executionContext) _Vehicle_id(ctx context.Context, field graphql.CollectedField, obj *model.Vehicle) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Vehicle_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5806,7 +9257,7 @@ func (ec *executionContext) _Trip_id(ctx context.Context, field graphql.Collecte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Trip().ID(rctx, obj)
+		return obj.Id, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5823,12 +9274,12 @@ func (ec *executionContext) _Trip_id(ctx context.Context, field graphql.Collecte
 	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Trip_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Vehicle_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Trip",
+		Object:     "Vehicle",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type ID does not have child fields")
 		},
@@ -5836,8 +9287,8 @@ func (ec *executionContext) fieldContext_Trip_id(ctx context.Context, field grap
 	return fc, nil
 }
 
-func (ec *executionContext) _Trip_route(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Trip_route(ctx, field)
+func (ec *executionContext) _Vehicle_label(ctx context.Context, field graphql.CollectedField, obj *model.Vehicle) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Vehicle_label(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5850,7 +9301,7 @@ func (ec *executionContext) _Trip_route(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Trip().Route(rctx, obj)
+		return obj.Label, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5862,36 +9313,26 @@ func (ec *executionContext) _Trip_route(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Route)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNRoute2stopᚑcheckerᚗcomᚋdbᚋmodelᚐRoute(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Trip_route(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Vehicle_label(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Trip",
+		Object:     "Vehicle",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Route_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Route_name(ctx, field)
-			case "text":
-				return ec.fieldContext_Route_text(ctx, field)
-			case "background":
-				return ec.fieldContext_Route_background(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Route", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Trip_stoptimes(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Trip_stoptimes(ctx, field)
+func (ec *executionContext) _Vehicle_trip(ctx context.Context, field graphql.CollectedField, obj *model.Vehicle) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Vehicle_trip(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5904,52 +9345,63 @@ func (ec *executionContext) _Trip_stoptimes(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Trip().Stoptimes(rctx, obj)
+		return ec.resolvers.Vehicle().Trip(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.StopTime)
+	res := resTmp.(*model.Trip)
 	fc.Result = res
-	return ec.marshalNStopTime2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐStopTimeᚄ(ctx, field.Selections, res)
+	return ec.marshalOTrip2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐTrip(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Trip_stoptimes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Vehicle_trip(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Trip",
+		Object:     "Vehicle",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_StopTime_id(ctx, field)
-			case "stop":
-				return ec.fieldContext_StopTime_stop(ctx, field)
-			case "trip":
-				return ec.fieldContext_StopTime_trip(ctx, field)
-			case "time":
-				return ec.fieldContext_StopTime_time(ctx, field)
-			case "sequence":
-				return ec.fieldContext_StopTime_sequence(ctx, field)
-			case "overflow":
-				return ec.fieldContext_StopTime_overflow(ctx, field)
+				return ec.fieldContext_Trip_id(ctx, field)
+			case "route":
+				return ec.fieldContext_Trip_route(ctx, field)
+			case "stoptimes":
+				return ec.fieldContext_Trip_stoptimes(ctx, field)
+			case "shape":
+				return ec.fieldContext_Trip_shape(ctx, field)
+			case "service":
+				return ec.fieldContext_Trip_service(ctx, field)
+			case "direction":
+				return ec.fieldContext_Trip_direction(ctx, field)
+			case "headsign":
+				return ec.fieldContext_Trip_headsign(ctx, field)
+			case "name":
+				return ec.fieldContext_Trip_name(ctx, field)
+			case "headway":
+				return ec.fieldContext_Trip_headway(ctx, field)
+			case "wheelchair":
+				return ec.fieldContext_Trip_wheelchair(ctx, field)
+			case "bikes":
+				return ec.fieldContext_Trip_bikes(ctx, field)
+			case "predictions":
+				return ec.fieldContext_Trip_predictions(ctx, field)
+			case "vehicle":
+				return ec.fieldContext_Trip_vehicle(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type StopTime", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Trip", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Trip_shape(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Trip_shape(ctx, field)
+func (ec *executionContext) _Vehicle_location(ctx context.Context, field graphql.CollectedField, obj *model.Vehicle) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Vehicle_location(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5962,7 +9414,7 @@ func (ec *executionContext) _Trip_shape(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Trip().Shape(rctx, obj)
+		return obj.Location, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5974,17 +9426,17 @@ func (ec *executionContext) _Trip_shape(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.Location)
+	res := resTmp.(model.Location)
 	fc.Result = res
-	return ec.marshalNLocation2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐLocationᚄ(ctx, field.Selections, res)
+	return ec.marshalNLocation2stopᚑcheckerᚗcomᚋdbᚋmodelᚐLocation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Trip_shape(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Vehicle_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Trip",
+		Object:     "Vehicle",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "latitude":
@@ -6000,8 +9452,8 @@ func (ec *executionContext) fieldContext_Trip_shape(ctx context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _Trip_service(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Trip_service(ctx, field)
+func (ec *executionContext) _Vehicle_bearing(ctx context.Context, field graphql.CollectedField, obj *model.Vehicle) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Vehicle_bearing(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6014,60 +9466,35 @@ func (ec *executionContext) _Trip_service(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Trip().Service(rctx, obj)
+		return obj.Bearing, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Service)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNService2stopᚑcheckerᚗcomᚋdbᚋmodelᚐService(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Trip_service(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Vehicle_bearing(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Trip",
+		Object:     "Vehicle",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "sunday":
-				return ec.fieldContext_Service_sunday(ctx, field)
-			case "monday":
-				return ec.fieldContext_Service_monday(ctx, field)
-			case "tuesday":
-				return ec.fieldContext_Service_tuesday(ctx, field)
-			case "wednesday":
-				return ec.fieldContext_Service_wednesday(ctx, field)
-			case "thursday":
-				return ec.fieldContext_Service_thursday(ctx, field)
-			case "friday":
-				return ec.fieldContext_Service_friday(ctx, field)
-			case "saturday":
-				return ec.fieldContext_Service_saturday(ctx, field)
-			case "start":
-				return ec.fieldContext_Service_start(ctx, field)
-			case "end":
-				return ec.fieldContext_Service_end(ctx, field)
-			case "exceptions":
-				return ec.fieldContext_Service_exceptions(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Service", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Trip_direction(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Trip_direction(ctx, field)
+func (ec *executionContext) _Vehicle_speed(ctx context.Context, field graphql.CollectedField, obj *model.Vehicle) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Vehicle_speed(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6080,38 +9507,35 @@ func (ec *executionContext) _Trip_direction(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Trip().Direction(rctx, obj)
+		return obj.Speed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Trip_direction(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Vehicle_speed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Trip",
+		Object:     "Vehicle",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Trip_headsign(ctx context.Context, field graphql.CollectedField, obj *model.Trip) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Trip_headsign(ctx, field)
+func (ec *executionContext) _Vehicle_lastUpdated(ctx context.Context, field graphql.CollectedField, obj *model.Vehicle) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Vehicle_lastUpdated(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6124,7 +9548,7 @@ func (ec *executionContext) _Trip_headsign(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Headsign, nil
+		return obj.LastUpdated, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6136,19 +9560,19 @@ func (ec *executionContext) _Trip_headsign(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDatetime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Trip_headsign(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Vehicle_lastUpdated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Trip",
+		Object:     "Vehicle",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Datetime does not have child fields")
 		},
 	}
 	return fc, nil
@@ -8094,42 +11518,211 @@ func (ec *executionContext) unmarshalInputTravelPlannerOptions(ctx context.Conte
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"datetime", "mode"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "datetime":
-			var err error
+	fieldsInOrder := [...]string{"datetime", "mode", "modes", "accessible", "realtime"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "datetime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("datetime"))
+			it.Datetime, err = ec.unmarshalODatetime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "mode":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mode"))
+			it.Mode, err = ec.unmarshalNScheduleMode2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐScheduleMode(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "modes":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("modes"))
+			it.Modes, err = ec.unmarshalORouteMode2ᚕstopᚑcheckerᚗcomᚋapplicationᚋschemaᚐRouteModeᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "accessible":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accessible"))
+			it.Accessible, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "realtime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("realtime"))
+			it.Realtime, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var alertImplementors = []string{"Alert"}
+
+func (ec *executionContext) _Alert(ctx context.Context, sel ast.SelectionSet, obj *model.Alert) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, alertImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Alert")
+		case "id":
+
+			out.Values[i] = ec._Alert_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "header":
+
+			out.Values[i] = ec._Alert_header(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "description":
+
+			out.Values[i] = ec._Alert_description(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "url":
+
+			out.Values[i] = ec._Alert_url(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "cause":
+
+			out.Values[i] = ec._Alert_cause(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "effect":
+
+			out.Values[i] = ec._Alert_effect(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "active":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Alert_active(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "periods":
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("datetime"))
-			it.Datetime, err = ec.unmarshalODatetime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "mode":
-			var err error
+			out.Values[i] = ec._Alert_periods(ctx, field, obj)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mode"))
-			it.Mode, err = ec.unmarshalNScheduleMode2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐScheduleMode(ctx, v)
-			if err != nil {
-				return it, err
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-// endregion **************************** input.gotpl *****************************
+var alertPeriodImplementors = []string{"AlertPeriod"}
 
-// region    ************************** interface.gotpl ***************************
+func (ec *executionContext) _AlertPeriod(ctx context.Context, sel ast.SelectionSet, obj *model.AlertPeriod) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, alertPeriodImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AlertPeriod")
+		case "start":
+			field := field
 
-// endregion ************************** interface.gotpl ***************************
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AlertPeriod_start(ctx, field, obj)
+				return res
+			}
 
-// region    **************************** object.gotpl ****************************
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "end":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AlertPeriod_end(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
 var busImplementors = []string{"Bus"}
 
@@ -8359,6 +11952,95 @@ func (ec *executionContext) _Path(ctx context.Context, sel ast.SelectionSet, obj
 	return out
 }
 
+var predictionImplementors = []string{"Prediction"}
+
+func (ec *executionContext) _Prediction(ctx context.Context, sel ast.SelectionSet, obj *model.Prediction) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, predictionImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Prediction")
+		case "stopTime":
+
+			out.Values[i] = ec._Prediction_stopTime(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "arrival":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Prediction_arrival(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "departure":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Prediction_departure(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "delay":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Prediction_delay(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "skipped":
+
+			out.Values[i] = ec._Prediction_skipped(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var queryImplementors = []string{"Query"}
 
 func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
@@ -8530,6 +12212,29 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "alerts":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_alerts(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
@@ -8575,7 +12280,102 @@ func (ec *executionContext) _Route(ctx context.Context, sel ast.SelectionSet, ob
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Route_id(ctx, field, obj)
+				res = ec._Route_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "agency":
+
+			out.Values[i] = ec._Route_agency(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+
+			out.Values[i] = ec._Route_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "longName":
+
+			out.Values[i] = ec._Route_longName(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "description":
+
+			out.Values[i] = ec._Route_description(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "url":
+
+			out.Values[i] = ec._Route_url(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "mode":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Route_mode(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "text":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Route_text(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "background":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Route_background(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -8586,14 +12386,7 @@ func (ec *executionContext) _Route(ctx context.Context, sel ast.SelectionSet, ob
 				return innerFunc(ctx)
 
 			})
-		case "name":
-
-			out.Values[i] = ec._Route_name(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "text":
+		case "vehicles":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -8602,7 +12395,7 @@ func (ec *executionContext) _Route(ctx context.Context, sel ast.SelectionSet, ob
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Route_text(ctx, field, obj)
+				res = ec._Route_vehicles(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -8613,7 +12406,7 @@ func (ec *executionContext) _Route(ctx context.Context, sel ast.SelectionSet, ob
 				return innerFunc(ctx)
 
 			})
-		case "background":
+		case "alerts":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -8622,7 +12415,7 @@ func (ec *executionContext) _Route(ctx context.Context, sel ast.SelectionSet, ob
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Route_background(ctx, field, obj)
+				res = ec._Route_alerts(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -8742,6 +12535,13 @@ func (ec *executionContext) _ScheduleResult(ctx context.Context, sel ast.Selecti
 				return innerFunc(ctx)
 
 			})
+		case "headsign":
+
+			out.Values[i] = ec._ScheduleResult_headsign(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -9024,6 +12824,13 @@ func (ec *executionContext) _Stop(ctx context.Context, sel ast.SelectionSet, obj
 
 			out.Values[i] = ec._Stop_code(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "agency":
+
+			out.Values[i] = ec._Stop_agency(ctx, field, obj)
+
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
@@ -9050,6 +12857,83 @@ func (ec *executionContext) _Stop(ctx context.Context, sel ast.SelectionSet, obj
 				return res
 			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "parent":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Stop_parent(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "platforms":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Stop_platforms(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "wheelchair":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Stop_wheelchair(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "alerts":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Stop_alerts(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
 			out.Concurrently(i, func() graphql.Marshaler {
 				return innerFunc(ctx)
 
@@ -9232,6 +13116,26 @@ func (ec *executionContext) _StopRoute(ctx context.Context, sel ast.SelectionSet
 				return res
 			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "alerts":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._StopRoute_alerts(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
 			out.Concurrently(i, func() graphql.Marshaler {
 				return innerFunc(ctx)
 
@@ -9328,7 +13232,155 @@ func (ec *executionContext) _StopTime(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._StopTime_trip(ctx, field, obj)
+				res = ec._StopTime_trip(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "arrival":
+
+			out.Values[i] = ec._StopTime_arrival(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "departure":
+
+			out.Values[i] = ec._StopTime_departure(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "time":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._StopTime_time(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "sequence":
+
+			out.Values[i] = ec._StopTime_sequence(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "overflow":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._StopTime_overflow(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "headsign":
+
+			out.Values[i] = ec._StopTime_headsign(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "pickup":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._StopTime_pickup(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "dropOff":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._StopTime_dropOff(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "pickupOnly":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._StopTime_pickupOnly(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "dropOffOnly":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._StopTime_dropOffOnly(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -9339,23 +13391,9 @@ func (ec *executionContext) _StopTime(ctx context.Context, sel ast.SelectionSet,
 				return innerFunc(ctx)
 
 			})
-		case "time":
-
-			out.Values[i] = ec._StopTime_time(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "sequence":
+		case "approximate":
 
-			out.Values[i] = ec._StopTime_sequence(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "overflow":
-
-			out.Values[i] = ec._StopTime_overflow(ctx, field, obj)
+			out.Values[i] = ec._StopTime_approximate(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
@@ -9371,6 +13409,26 @@ func (ec *executionContext) _StopTime(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "stopArrivals":
+		return ec._Subscription_stopArrivals(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
 var transitImplementors = []string{"Transit"}
 
 func (ec *executionContext) _Transit(ctx context.Context, sel ast.SelectionSet, obj *model.Transit) graphql.Marshaler {
@@ -9606,6 +13664,26 @@ func (ec *executionContext) _TravelScheduleLeg(ctx context.Context, sel ast.Sele
 
 			out.Values[i] = ec._TravelScheduleLeg_walk(ctx, field, obj)
 
+		case "alerts":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._TravelScheduleLeg_alerts(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -9832,6 +13910,178 @@ func (ec *executionContext) _Trip(ctx context.Context, sel ast.SelectionSet, obj
 
 			out.Values[i] = ec._Trip_headsign(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+
+			out.Values[i] = ec._Trip_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "headway":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Trip_headway(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "wheelchair":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Trip_wheelchair(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "bikes":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Trip_bikes(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "predictions":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Trip_predictions(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "vehicle":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Trip_vehicle(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var vehicleImplementors = []string{"Vehicle"}
+
+func (ec *executionContext) _Vehicle(ctx context.Context, sel ast.SelectionSet, obj *model.Vehicle) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, vehicleImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Vehicle")
+		case "id":
+
+			out.Values[i] = ec._Vehicle_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "label":
+
+			out.Values[i] = ec._Vehicle_label(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "trip":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Vehicle_trip(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "location":
+
+			out.Values[i] = ec._Vehicle_location(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "bearing":
+
+			out.Values[i] = ec._Vehicle_bearing(ctx, field, obj)
+
+		case "speed":
+
+			out.Values[i] = ec._Vehicle_speed(ctx, field, obj)
+
+		case "lastUpdated":
+
+			out.Values[i] = ec._Vehicle_lastUpdated(ctx, field, obj)
+
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
@@ -10139,30 +14389,136 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 
 		case "inputFields":
 
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+
+		case "ofType":
+
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+
+		case "specifiedByURL":
+
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNAccessibility2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐAccessibility(ctx context.Context, v interface{}) (Accessibility, error) {
+	var res Accessibility
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAccessibility2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐAccessibility(ctx context.Context, sel ast.SelectionSet, v Accessibility) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNAlert2stopᚑcheckerᚗcomᚋdbᚋmodelᚐAlert(ctx context.Context, sel ast.SelectionSet, v model.Alert) graphql.Marshaler {
+	return ec._Alert(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAlert2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐAlertᚄ(ctx context.Context, sel ast.SelectionSet, v []model.Alert) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAlert2stopᚑcheckerᚗcomᚋdbᚋmodelᚐAlert(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-		case "ofType":
+	return ret
+}
 
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+func (ec *executionContext) marshalNAlertPeriod2stopᚑcheckerᚗcomᚋdbᚋmodelᚐAlertPeriod(ctx context.Context, sel ast.SelectionSet, v model.AlertPeriod) graphql.Marshaler {
+	return ec._AlertPeriod(ctx, sel, &v)
+}
 
-		case "specifiedByURL":
+func (ec *executionContext) marshalNAlertPeriod2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐAlertPeriodᚄ(ctx context.Context, sel ast.SelectionSet, v []model.AlertPeriod) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAlertPeriod2stopᚑcheckerᚗcomᚋdbᚋmodelᚐAlertPeriod(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+	}
+	wg.Wait()
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-// endregion **************************** object.gotpl ****************************
 
-// region    ***************************** type.gotpl *****************************
+	return ret
+}
 
 func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
@@ -10243,7 +14599,7 @@ func (ec *executionContext) marshalNColor2string(ctx context.Context, sel ast.Se
 }
 
 func (ec *executionContext) unmarshalNDate2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
-	res, err := scalars.UnmarshalDate(v)
+	res, err := scalars.UnmarshalDate(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
@@ -10254,11 +14610,11 @@ func (ec *executionContext) marshalNDate2timeᚐTime(ctx context.Context, sel as
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	return res
+	return graphql.WrapContextMarshaler(ctx, res)
 }
 
 func (ec *executionContext) unmarshalNDatetime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
-	res, err := scalars.UnmarshalDateTime(v)
+	res, err := scalars.UnmarshalDateTime(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
@@ -10269,7 +14625,7 @@ func (ec *executionContext) marshalNDatetime2timeᚐTime(ctx context.Context, se
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	return res
+	return graphql.WrapContextMarshaler(ctx, res)
 }
 
 func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
@@ -10385,10 +14741,34 @@ func (ec *executionContext) unmarshalNPageInput2stopᚑcheckerᚗcomᚋapplicati
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNPickupDropOff2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐPickupDropOff(ctx context.Context, v interface{}) (PickupDropOff, error) {
+	var res PickupDropOff
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNPickupDropOff2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐPickupDropOff(ctx context.Context, sel ast.SelectionSet, v PickupDropOff) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNPrediction2stopᚑcheckerᚗcomᚋdbᚋmodelᚐPrediction(ctx context.Context, sel ast.SelectionSet, v model.Prediction) graphql.Marshaler {
+	return ec._Prediction(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNRoute2stopᚑcheckerᚗcomᚋdbᚋmodelᚐRoute(ctx context.Context, sel ast.SelectionSet, v model.Route) graphql.Marshaler {
 	return ec._Route(ctx, sel, &v)
 }
 
+func (ec *executionContext) unmarshalNRouteMode2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐRouteMode(ctx context.Context, v interface{}) (RouteMode, error) {
+	var res RouteMode
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRouteMode2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐRouteMode(ctx context.Context, sel ast.SelectionSet, v RouteMode) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNSchedule2stopᚑcheckerᚗcomᚋdbᚋrepositoryᚐSchedule(ctx context.Context, sel ast.SelectionSet, v repository.Schedule) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -10835,6 +15215,54 @@ func (ec *executionContext) marshalNTrip2stopᚑcheckerᚗcomᚋdbᚋmodelᚐTri
 	return ec._Trip(ctx, sel, &v)
 }
 
+func (ec *executionContext) marshalNVehicle2stopᚑcheckerᚗcomᚋdbᚋmodelᚐVehicle(ctx context.Context, sel ast.SelectionSet, v model.Vehicle) graphql.Marshaler {
+	return ec._Vehicle(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNVehicle2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐVehicleᚄ(ctx context.Context, sel ast.SelectionSet, v []model.Vehicle) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNVehicle2stopᚑcheckerᚗcomᚋdbᚋmodelᚐVehicle(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -11118,7 +15546,7 @@ func (ec *executionContext) unmarshalODatetime2ᚖtimeᚐTime(ctx context.Contex
 	if v == nil {
 		return nil, nil
 	}
-	res, err := scalars.UnmarshalDateTime(v)
+	res, err := scalars.UnmarshalDateTime(ctx, v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
@@ -11127,7 +15555,7 @@ func (ec *executionContext) marshalODatetime2ᚖtimeᚐTime(ctx context.Context,
 		return graphql.Null
 	}
 	res := scalars.MarshalDateTime(*v)
-	return res
+	return graphql.WrapContextMarshaler(ctx, res)
 }
 
 func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v interface{}) (*float64, error) {
@@ -11146,6 +15574,22 @@ func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel as
 	return graphql.WrapContextMarshaler(ctx, res)
 }
 
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalInt(*v)
+	return res
+}
+
 func (ec *executionContext) marshalOLocation2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐLocation(ctx context.Context, sel ast.SelectionSet, v *model.Location) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -11160,6 +15604,120 @@ func (ec *executionContext) marshalOPath2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐ
 	return ec._Path(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOPrediction2ᚕstopᚑcheckerᚗcomᚋdbᚋmodelᚐPredictionᚄ(ctx context.Context, sel ast.SelectionSet, v []model.Prediction) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPrediction2stopᚑcheckerᚗcomᚋdbᚋmodelᚐPrediction(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalORouteMode2ᚕstopᚑcheckerᚗcomᚋapplicationᚋschemaᚐRouteModeᚄ(ctx context.Context, v interface{}) ([]RouteMode, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]RouteMode, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRouteMode2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐRouteMode(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalORouteMode2ᚕstopᚑcheckerᚗcomᚋapplicationᚋschemaᚐRouteModeᚄ(ctx context.Context, sel ast.SelectionSet, v []RouteMode) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRouteMode2stopᚑcheckerᚗcomᚋapplicationᚋschemaᚐRouteMode(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalOSchedule2stopᚑcheckerᚗcomᚋdbᚋrepositoryᚐSchedule(ctx context.Context, sel ast.SelectionSet, v repository.Schedule) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -11216,6 +15774,20 @@ func (ec *executionContext) marshalOTravelSchedule2ᚖstopᚑcheckerᚗcomᚋdb
 	return ec._TravelSchedule(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOTrip2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐTrip(ctx context.Context, sel ast.SelectionSet, v *model.Trip) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Trip(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOVehicle2ᚖstopᚑcheckerᚗcomᚋdbᚋmodelᚐVehicle(ctx context.Context, sel ast.SelectionSet, v *model.Vehicle) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Vehicle(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -27,8 +27,11 @@ type StopSearchPayload struct {
 }
 
 type TravelPlannerOptions struct {
-	Datetime *time.Time   `json:"datetime"`
-	Mode     ScheduleMode `json:"mode"`
+	Datetime   *time.Time   `json:"datetime"`
+	Mode       ScheduleMode `json:"mode"`
+	Modes      []RouteMode  `json:"modes"`
+	Accessible *bool        `json:"accessible"`
+	Realtime   *bool        `json:"realtime"`
 }
 
 type TravelSchedulePayload struct {
@@ -36,6 +39,151 @@ type TravelSchedulePayload struct {
 	Error    *string               `json:"error"`
 }
 
+type Accessibility string
+
+const (
+	AccessibilityUnknown       Accessibility = "UNKNOWN"
+	AccessibilityAccessible    Accessibility = "ACCESSIBLE"
+	AccessibilityNotAccessible Accessibility = "NOT_ACCESSIBLE"
+)
+
+var AllAccessibility = []Accessibility{
+	AccessibilityUnknown,
+	AccessibilityAccessible,
+	AccessibilityNotAccessible,
+}
+
+func (e Accessibility) IsValid() bool {
+	switch e {
+	case AccessibilityUnknown, AccessibilityAccessible, AccessibilityNotAccessible:
+		return true
+	}
+	return false
+}
+
+func (e Accessibility) String() string {
+	return string(e)
+}
+
+func (e *Accessibility) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Accessibility(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Accessibility", str)
+	}
+	return nil
+}
+
+func (e Accessibility) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PickupDropOff string
+
+const (
+	PickupDropOffRegular              PickupDropOff = "REGULAR"
+	PickupDropOffNone                 PickupDropOff = "NONE"
+	PickupDropOffPhoneAgency          PickupDropOff = "PHONE_AGENCY"
+	PickupDropOffCoordinateWithDriver PickupDropOff = "COORDINATE_WITH_DRIVER"
+)
+
+var AllPickupDropOff = []PickupDropOff{
+	PickupDropOffRegular,
+	PickupDropOffNone,
+	PickupDropOffPhoneAgency,
+	PickupDropOffCoordinateWithDriver,
+}
+
+func (e PickupDropOff) IsValid() bool {
+	switch e {
+	case PickupDropOffRegular, PickupDropOffNone, PickupDropOffPhoneAgency, PickupDropOffCoordinateWithDriver:
+		return true
+	}
+	return false
+}
+
+func (e PickupDropOff) String() string {
+	return string(e)
+}
+
+func (e *PickupDropOff) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PickupDropOff(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PickupDropOff", str)
+	}
+	return nil
+}
+
+func (e PickupDropOff) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RouteMode string
+
+const (
+	RouteModeLrt        RouteMode = "LRT"
+	RouteModeSubway     RouteMode = "SUBWAY"
+	RouteModeRail       RouteMode = "RAIL"
+	RouteModeBus        RouteMode = "BUS"
+	RouteModeFerry      RouteMode = "FERRY"
+	RouteModeCableTram  RouteMode = "CABLE_TRAM"
+	RouteModeAerialLift RouteMode = "AERIAL_LIFT"
+	RouteModeFunicular  RouteMode = "FUNICULAR"
+	RouteModeTrolleybus RouteMode = "TROLLEYBUS"
+	RouteModeMonorail   RouteMode = "MONORAIL"
+)
+
+var AllRouteMode = []RouteMode{
+	RouteModeLrt,
+	RouteModeSubway,
+	RouteModeRail,
+	RouteModeBus,
+	RouteModeFerry,
+	RouteModeCableTram,
+	RouteModeAerialLift,
+	RouteModeFunicular,
+	RouteModeTrolleybus,
+	RouteModeMonorail,
+}
+
+func (e RouteMode) IsValid() bool {
+	switch e {
+	case RouteModeLrt, RouteModeSubway, RouteModeRail, RouteModeBus, RouteModeFerry, RouteModeCableTram, RouteModeAerialLift, RouteModeFunicular, RouteModeTrolleybus, RouteModeMonorail:
+		return true
+	}
+	return false
+}
+
+func (e RouteMode) String() string {
+	return string(e)
+}
+
+func (e *RouteMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RouteMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RouteMode", str)
+	}
+	return nil
+}
+
+func (e RouteMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ScheduleMode string
 
 const (
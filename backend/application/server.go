@@ -2,13 +2,22 @@ package application
 
 import (
 	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
 	"github.com/rs/cors"
 	"stop-checker.com/application/resolvers"
+	"stop-checker.com/application/resolvers/scalars"
 	"stop-checker.com/application/schema"
 	"stop-checker.com/application/services"
 	"stop-checker.com/db/model"
@@ -28,77 +37,158 @@ type ServerDependencies struct {
 	repository.StopLocationSearch
 	repository.StopTextSearch
 	StopTimesByTrip repository.InvertedIndex[model.StopTime]
+	StopsByParent   repository.InvertedIndex[model.Stop]
 	services.TravelPlanner
 	services.TravelScheduler
 	services.OCTranspo
+	services.LiveBuses
 	services.StaticMapEncoder
+	TripUpdates      services.TripUpdates      // nil without a GTFS-Realtime feed
+	VehiclePositions services.VehiclePositions // nil without a GTFS-Realtime feed
+	Alerts           services.Alerts           // nil without a GTFS-Realtime feed
+	Location         *time.Location            // timezone of the agencies
 }
 
 type ServerConfig struct {
 	EnableCORS       bool
 	EnablePlayground bool
+	OCTranspoAgency  string // agency of the stops with live data from OC Transpo
+	AdminToken       string // bearer token of the admin endpoints, disabled when empty
 }
 
 type Server struct {
-	config *ServerConfig
-	schema graphql.ExecutableSchema
+	config  *ServerConfig
+	handler atomic.Value // http.Handler of the GraphQL API, replaced when the dependencies are reloaded
+
+	// builds the dependencies from the latest data, nil when reloading is disabled
+	build     func() (*ServerDependencies, error)
+	reloading sync.Mutex
+	debounce  time.Duration // time without changes to the watched feeds before reloading
 }
 
 func NewServer(config *ServerConfig, deps *ServerDependencies) *Server {
-	return &Server{
-		config: config,
-		schema: schema.NewExecutableSchema(schema.Config{
-			Resolvers: &resolvers.Root{
-				BusResolver:      &resolvers.BusResolvers{},
-				LocationResolver: &resolvers.LocationResolvers{},
-				QueryResolver: &resolvers.QueryResolver{
-					Stops:              deps.Stops,
-					StopRoutes:         deps.StopRoutes,
-					StopLocationSearch: deps.StopLocationSearch,
-					StopTextSearch:     deps.StopTextSearch,
-					QueryTravelPlanner: &resolvers.QueryTravelPlanner{
-						Planner:   deps.TravelPlanner,
-						Scheduler: deps.TravelScheduler,
-					},
-				},
-				RouteResolver:          &resolvers.RouteResolvers{},
-				ScheduleResolver:       &resolvers.ScheduleResolvers{},
-				ScheduleResultResolver: &resolvers.ScheduleResultResolvers{},
-				ServiceResolver:        &resolvers.ServiceResolvers{},
-				StopResolver: &resolvers.StopResolvers{
-					StopRoutes: deps.StopRoutes,
-				},
-				StopRouteResolver: &resolvers.StopRouteResolvers{
-					Stops:            deps.Stops,
-					Routes:           deps.Routes,
-					Schedules:        deps.Schedules,
-					Reach:            deps.Reach,
-					OCTranspo:        deps.OCTranspo,
-					StaticMapEncoder: deps.StaticMapEncoder,
-				},
-				StopTimeResolver: &resolvers.StopTimeResolvers{
-					Trips: deps.Trips,
-					Stops: deps.Stops,
-				},
-				TransitResolver: &resolvers.TransitResolvers{
-					Routes: deps.Routes,
-					Trips:  deps.Trips,
-					Shapes: deps.Shapes,
-				},
-				TravelScheduleResolver:    &resolvers.TravelScheduleResolvers{},
-				TravelScheduleLegResolver: &resolvers.TravelScheduleLegResolvers{},
-				TravelScheduleNodeResolver: &resolvers.TravelScheduleNodeResolvers{
-					Stops: deps.Stops,
-				},
-				TripResolver: &resolvers.TripResolvers{
-					Routes:          deps.Routes,
-					Shapes:          deps.Shapes,
-					Services:        deps.Services,
-					StopTimesByTrip: deps.StopTimesByTrip,
+	server := &Server{config: config, debounce: reloadDebounce}
+	server.swap(deps)
+	return server
+}
+
+// swap the GraphQL handler for one using the dependencies. requests in progress finish with the old handler
+func (s *Server) swap(deps *ServerDependencies) {
+	s.handler.Store(newHandler(s.config, deps))
+}
+
+// same as handler.NewDefaultServer with websockets accepting the origins allowed by CORS
+func newHandler(config *ServerConfig, deps *ServerDependencies) http.Handler {
+	srv := handler.New(newSchema(config, deps))
+
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: config.checkOrigin,
+		},
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+
+	srv.SetQueryCache(lru.New(1000))
+
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(100),
+	})
+
+	// dates and times from clients are in the timezone of the handler's data, even after a reload
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		srv.ServeHTTP(w, r.WithContext(scalars.WithLocation(r.Context(), deps.Location)))
+	})
+}
+
+func newSchema(config *ServerConfig, deps *ServerDependencies) graphql.ExecutableSchema {
+	return schema.NewExecutableSchema(schema.Config{
+		Resolvers: &resolvers.Root{
+			AlertResolver:       &resolvers.AlertResolvers{},
+			AlertPeriodResolver: &resolvers.AlertPeriodResolvers{},
+			BusResolver:         &resolvers.BusResolvers{},
+			LocationResolver:    &resolvers.LocationResolvers{},
+			PredictionResolver:  &resolvers.PredictionResolvers{},
+			QueryResolver: &resolvers.QueryResolver{
+				Stops:              deps.Stops,
+				StopRoutes:         deps.StopRoutes,
+				StopLocationSearch: deps.StopLocationSearch,
+				StopTextSearch:     deps.StopTextSearch,
+				QueryTravelPlanner: &resolvers.QueryTravelPlanner{
+					Planner:   deps.TravelPlanner,
+					Scheduler: deps.TravelScheduler,
+					Location:  deps.Location,
 				},
+				ServiceAlerts: deps.Alerts,
 			},
-		}),
-	}
+			RouteResolver: &resolvers.RouteResolvers{
+				Trips:            deps.Trips,
+				VehiclePositions: deps.VehiclePositions,
+				ServiceAlerts:    deps.Alerts,
+			},
+			ScheduleResolver: &resolvers.ScheduleResolvers{
+				Location: deps.Location,
+			},
+			ScheduleResultResolver: &resolvers.ScheduleResultResolvers{
+				Trips: deps.Trips,
+			},
+			ServiceResolver: &resolvers.ServiceResolvers{},
+			StopResolver: &resolvers.StopResolvers{
+				Stops:         deps.Stops,
+				StopRoutes:    deps.StopRoutes,
+				StopsByParent: deps.StopsByParent,
+				ServiceAlerts: deps.Alerts,
+			},
+			StopRouteResolver: &resolvers.StopRouteResolvers{
+				Stops:            deps.Stops,
+				Routes:           deps.Routes,
+				Schedules:        deps.Schedules,
+				Reach:            deps.Reach,
+				OCTranspo:        deps.OCTranspo,
+				OCTranspoAgency:  config.OCTranspoAgency,
+				StaticMapEncoder: deps.StaticMapEncoder,
+				ServiceAlerts:    deps.Alerts,
+			},
+			StopTimeResolver: &resolvers.StopTimeResolvers{
+				Trips: deps.Trips,
+				Stops: deps.Stops,
+			},
+			SubscriptionResolver: &resolvers.SubscriptionResolver{
+				Stops:           deps.Stops,
+				Routes:          deps.Routes,
+				StopRoutes:      deps.StopRoutes,
+				LiveBuses:       deps.LiveBuses,
+				OCTranspoAgency: config.OCTranspoAgency,
+			},
+			TransitResolver: &resolvers.TransitResolvers{
+				Routes: deps.Routes,
+				Trips:  deps.Trips,
+				Shapes: deps.Shapes,
+			},
+			TravelScheduleResolver: &resolvers.TravelScheduleResolvers{},
+			TravelScheduleLegResolver: &resolvers.TravelScheduleLegResolvers{
+				ServiceAlerts: deps.Alerts,
+			},
+			TravelScheduleNodeResolver: &resolvers.TravelScheduleNodeResolvers{
+				Stops: deps.Stops,
+			},
+			TripResolver: &resolvers.TripResolvers{
+				Routes:           deps.Routes,
+				Shapes:           deps.Shapes,
+				Services:         deps.Services,
+				StopTimesByTrip:  deps.StopTimesByTrip,
+				TripUpdates:      deps.TripUpdates,
+				VehiclePositions: deps.VehiclePositions,
+			},
+			VehicleResolver: &resolvers.VehicleResolvers{
+				Trips: deps.Trips,
+			},
+		},
+	})
 }
 
 func (s *Server) Run(port string) {
@@ -106,19 +196,46 @@ func (s *Server) Run(port string) {
 
 	r.Use(s.CORSMiddleware())
 
-	graphqlHandler := handler.NewDefaultServer(s.schema)
-
-	if s.config.EnablePlayground {
-		r.Get("/graphql", playground.Handler("stop-checker.com", "/graphql"))
+	graphqlHandler := func(w http.ResponseWriter, r *http.Request) {
+		s.handler.Load().(http.Handler).ServeHTTP(w, r)
 	}
+	playgroundHandler := playground.Handler("stop-checker.com", "/graphql")
 
-	r.Post("/graphql", func(w http.ResponseWriter, r *http.Request) {
-		graphqlHandler.ServeHTTP(w, r)
+	// subscriptions upgrade GET requests to websockets
+	r.Get("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case websocket.IsWebSocketUpgrade(r):
+			graphqlHandler(w, r)
+		case s.config.EnablePlayground:
+			playgroundHandler(w, r)
+		default:
+			http.NotFound(w, r)
+		}
 	})
 
+	r.Post("/graphql", graphqlHandler)
+
+	if s.config.AdminToken != "" && s.build != nil {
+		r.Post("/admin/reload", s.ReloadHandler)
+	}
+
 	http.ListenAndServe(port, r)
 }
 
+// websocket origins allowed by the CORS middleware
+func (c *ServerConfig) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if c.EnableCORS || origin == "" {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return u.Host == "stop-checker.com" || u.Host == "www.stop-checker.com"
+}
+
 func (s *Server) CORSMiddleware() func(h http.Handler) http.Handler {
 	if s.config.EnableCORS {
 		// allow all origins
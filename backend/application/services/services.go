@@ -1,20 +1,22 @@
 package services
 
 import (
+	"context"
 	"time"
 
 	"stop-checker.com/db/model"
 	"stop-checker.com/features/staticmaps"
+	"stop-checker.com/features/travel"
 )
 
 type TravelPlanner interface {
-	Depart(at time.Time, origin, destination model.Location) (*model.TravelPlan, error)
-	Arrive(by time.Time, origin, destination model.Location) (*model.TravelPlan, error)
+	Depart(at time.Time, origin, destination model.Location, options travel.PlannerOptions) (*model.TravelPlan, error)
+	Arrive(by time.Time, origin, destination model.Location, options travel.PlannerOptions) (*model.TravelPlan, error)
 }
 
 type TravelScheduler interface {
-	Depart(at time.Time, plan *model.TravelPlan) (*model.TravelSchedule, error)
-	Arrive(by time.Time, plan *model.TravelPlan) (*model.TravelSchedule, error)
+	Depart(at time.Time, plan *model.TravelPlan, options travel.PlannerOptions) (*model.TravelSchedule, error)
+	Arrive(by time.Time, plan *model.TravelPlan, options travel.PlannerOptions) (*model.TravelSchedule, error)
 }
 
 type OCTranspo interface {
@@ -22,6 +24,27 @@ type OCTranspo interface {
 	StopRouteData(stop model.Stop, routeName string, routeDirection string) ([]model.Bus, error)
 }
 
+type LiveBuses interface {
+	Subscribe(ctx context.Context, stop model.Stop, routeName string, routeDirection string) <-chan []model.Bus
+}
+
+type TripUpdates interface {
+	Predict(scheduled []model.StopTime) ([]model.Prediction, bool)
+}
+
+type VehiclePositions interface {
+	Trip(tripId string) (model.Vehicle, bool)
+	Vehicles() []model.Vehicle
+}
+
+type Alerts interface {
+	All() []model.Alert
+	Stop(stopId string) []model.Alert
+	Route(routeId string) []model.Alert
+	StopRoute(stopId, routeId string) []model.Alert
+	Trip(tripId string) []model.Alert
+}
+
 type StaticMapEncoder interface {
 	Encode(m *staticmaps.Map) string
 }
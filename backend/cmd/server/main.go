@@ -3,8 +3,11 @@ package main
 import (
 	"time"
 
+	"github.com/rs/zerolog/log"
 	"stop-checker.com/application"
+	"stop-checker.com/application/services"
 	"stop-checker.com/db"
+	"stop-checker.com/features/gtfsrt"
 	"stop-checker.com/features/octranspo"
 	"stop-checker.com/features/osrm"
 	"stop-checker.com/features/staticmaps"
@@ -16,7 +19,21 @@ func main() {
 	config := application.GetConfig()
 
 	// db setup
-	database, _ := db.NewDBFromFilesystem(config.DATA_GTFS)
+	filter := db.FeedFilter{
+		Start:    config.DATA_FILTER.Start,
+		End:      config.DATA_FILTER.End,
+		Routes:   config.DATA_FILTER.Routes,
+		Agencies: config.DATA_FILTER.Agencies,
+	}
+
+	feeds := []db.Feed{}
+	for _, feed := range config.DATA_FEEDS {
+		feeds = append(feeds, db.Feed{Prefix: feed.Prefix, Agency: feed.Agency, Path: feed.Path, Filter: filter})
+	}
+	database, err := loadDB(feeds, config.DATA_SNAPSHOT)
+	if err != nil {
+		panic(err)
+	}
 
 	// osrm setup
 	directionsCacheData, err := osrm.ReadCacheData(config.DATA_DIRECTIONS)
@@ -27,41 +44,81 @@ func main() {
 	directions := osrm.NewClient(config.OSRM_ENDPOINT)
 
 	// octranspo
-	octranspoAPI := octranspo.NewAPI(time.Second*30, &octranspo.Client{
+	octranspoMetrics := &octranspo.APIMetricsCounter{}
+	octranspoMetrics.Log(time.Minute)
+
+	octranspoAPI := octranspo.NewAPI(time.Second*30, config.OCTRANSPO_CACHE_SIZE, &octranspo.Client{
 		Endpoint:          config.OCTRANSPO_ENDPOINT,
 		OCTRANSPO_APP_ID:  config.OCTRANSPO_APP_ID,
 		OCTRANSPO_API_KEY: config.OCTRANSPO_API_KEY,
-	})
+	}, octranspoMetrics)
+
+	// live buses pushed to subscribers. polled twice per TTL since expired data is refreshed in the background
+	liveBuses := octranspo.NewSubscriptions(octranspoAPI, time.Second*15)
 
 	// map encoder
 	mapEncoder := &staticmaps.GoogleMapEncoder{
 		Key: config.GOOGLE_MAPS_API_KEY,
 	}
 
-	// travel setup
-	planner := travel.NewPlanner(
-		database.StopLocationIndex,
-		database.StopRouteIndex,
-		database.ReachIndex,
-		directionsCache,
-		directions,
-		&travel.PlannerMetricsEmpty{},
-	)
+	// gtfs realtime
+	var updates *gtfsrt.TripUpdates
+	if config.GTFSRT_TRIP_UPDATES != "" {
+		updates = gtfsrt.NewTripUpdates(config.GTFSRT_PREFIX)
+		gtfsrt.Poll(config.GTFSRT_TRIP_UPDATES, config.GTFSRT_INTERVAL, updates.Load)
+	}
 
-	scheduler := travel.NewScheduler(
-		directions,
-		directionsCache,
-		database.Stops,
-		database.ReachIndex,
-		database.StopTimesByTrip,
-	)
+	var vehiclePositions services.VehiclePositions
+	if config.GTFSRT_VEHICLE_POSITIONS != "" {
+		positions := gtfsrt.NewVehiclePositions(config.GTFSRT_PREFIX)
+		gtfsrt.Poll(config.GTFSRT_VEHICLE_POSITIONS, config.GTFSRT_INTERVAL, positions.Load)
+		vehiclePositions = positions
+	}
 
-	server := application.NewServer(
-		&application.ServerConfig{
-			EnableCORS:       config.SERVER_ENABLE_CORS,
-			EnablePlayground: config.SERVER_ENABLE_PLAYGROUND,
-		},
-		&application.ServerDependencies{
+	var alerts services.Alerts
+	if config.GTFSRT_ALERTS != "" {
+		serviceAlerts := gtfsrt.NewAlerts(config.GTFSRT_PREFIX)
+		gtfsrt.Poll(config.GTFSRT_ALERTS, config.GTFSRT_INTERVAL, serviceAlerts.Load)
+		alerts = serviceAlerts
+	}
+
+	// dependencies that use the DB. the others are kept when reloading
+	dependencies := func(database *db.DB) *application.ServerDependencies {
+		// service days of the predictions are in the timezone of the DB
+		var tripUpdates services.TripUpdates
+		if updates != nil {
+			tripUpdates = updates.Predictor(database.Location)
+		}
+
+		planner := travel.NewPlanner(
+			database.StopLocationIndex,
+			database.StopRouteIndex,
+			database.Routes,
+			database.Trips,
+			database.Stops,
+			database.StopsByParent,
+			database.Transfers,
+			database.Pathways,
+			database.ReachIndex,
+			database.StopTimesByTrip,
+			tripUpdates,
+			directionsCache,
+			directions,
+			&travel.PlannerMetricsEmpty{},
+		)
+
+		scheduler := travel.NewScheduler(
+			directions,
+			directionsCache,
+			database.Stops,
+			database.Transfers,
+			database.Pathways,
+			database.ReachIndex,
+			database.StopTimesByTrip,
+			tripUpdates,
+		)
+
+		return &application.ServerDependencies{
 			Stops:              database.Stops,
 			StopRoutes:         database.StopRouteIndex,
 			Routes:             database.Routes,
@@ -74,11 +131,68 @@ func main() {
 			StopLocationSearch: database.StopLocationIndex,
 			StopTextSearch:     database.StopTextIndex,
 			StopTimesByTrip:    database.StopTimesByTrip,
+			StopsByParent:      database.StopsByParent,
 			TravelPlanner:      planner,
 			TravelScheduler:    scheduler,
 			OCTranspo:          octranspoAPI,
+			LiveBuses:          liveBuses,
 			StaticMapEncoder:   mapEncoder,
-		})
+			TripUpdates:        tripUpdates,
+			VehiclePositions:   vehiclePositions,
+			Alerts:             alerts,
+			Location:           database.Location,
+		}
+	}
+
+	server := application.NewServer(
+		&application.ServerConfig{
+			EnableCORS:       config.SERVER_ENABLE_CORS,
+			EnablePlayground: config.SERVER_ENABLE_PLAYGROUND,
+			OCTranspoAgency:  config.OCTRANSPO_AGENCY,
+			AdminToken:       config.SERVER_ADMIN_TOKEN,
+		},
+		dependencies(database),
+	)
+
+	// reload
+	server.EnableReload(func() (*application.ServerDependencies, error) {
+		database, err := loadDB(feeds, config.DATA_SNAPSHOT)
+		if err != nil {
+			return nil, err
+		}
+		return dependencies(database), nil
+	})
+
+	if config.DATA_WATCH {
+		paths := []string{}
+		for _, feed := range feeds {
+			paths = append(paths, feed.Path)
+		}
+		if err := server.Watch(paths); err != nil {
+			panic(err)
+		}
+	}
 
 	server.Run(config.SERVER_PORT)
 }
+
+// load the DB from the snapshot when it was created from the same feeds, otherwise read the feeds
+func loadDB(feeds []db.Feed, snapshot string) (*db.DB, error) {
+	if snapshot == "" {
+		database, _, err := db.NewDBFromFeeds(feeds)
+		return database, err
+	}
+
+	hash, err := db.FeedsHash(feeds)
+	if err != nil {
+		return nil, err
+	}
+
+	database, _, err := db.NewDBFromSnapshot(snapshot, hash)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to load the DB snapshot, reading the feeds instead")
+		database, _, err = db.NewDBFromFeeds(feeds)
+	}
+
+	return database, err
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"github.com/rs/zerolog/log"
+	"stop-checker.com/application"
+	"stop-checker.com/db"
+)
+
+/* snapshot the DB built from the configured feeds so the server can skip reading them
+usage: go run cmd/snapshot/main.go --config=dev
+writes to data.snapshot, the server uses it while the feeds are unchanged
+*/
+func main() {
+	application.ReadConfig()
+	config := application.GetConfig()
+
+	if config.DATA_SNAPSHOT == "" {
+		log.Fatal().Msg("data.snapshot is not configured")
+	}
+
+	filter := db.FeedFilter{
+		Start:    config.DATA_FILTER.Start,
+		End:      config.DATA_FILTER.End,
+		Routes:   config.DATA_FILTER.Routes,
+		Agencies: config.DATA_FILTER.Agencies,
+	}
+
+	feeds := []db.Feed{}
+	for _, feed := range config.DATA_FEEDS {
+		feeds = append(feeds, db.Feed{Prefix: feed.Prefix, Agency: feed.Agency, Path: feed.Path, Filter: filter})
+	}
+
+	hash, err := db.FeedsHash(feeds)
+	if err != nil {
+		panic(err)
+	}
+
+	database, dataset, err := db.NewDBFromFeeds(feeds)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := db.WriteSnapshot(config.DATA_SNAPSHOT, hash, database, dataset); err != nil {
+		panic(err)
+	}
+}
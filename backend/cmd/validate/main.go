@@ -0,0 +1,57 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"stop-checker.com/db/gtfs"
+)
+
+/* validate a GTFS feed before deploying it
+usage: go run cmd/validate/main.go [--warnings] ./data/gtfs.zip
+prints a JSON report and exits with status 1 when the feed has errors */
+func main() {
+	warnings := flag.Bool("warnings", false, "include warnings in the report")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: validate [--warnings] <gtfs directory or .zip>")
+		os.Exit(2)
+	}
+
+	path := flag.Arg(0)
+	report, err := gtfs.Validate(path)
+	if err != nil {
+		log.Error().Err(err).Str("path", path).Msg("failed to open GTFS feed")
+		os.Exit(2)
+	}
+
+	if !*warnings {
+		issues := []gtfs.Issue{}
+		for _, issue := range report.Issues {
+			if issue.Severity == gtfs.SeverityError {
+				issues = append(issues, issue)
+			}
+		}
+		report.Issues = issues
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		panic(err)
+	}
+
+	log.Info().
+		Str("path", path).
+		Int("errors", report.Errors).
+		Int("warnings", report.Warnings).
+		Msg("validated GTFS feed")
+
+	if report.Errors > 0 {
+		os.Exit(1)
+	}
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"fmt"
 	"runtime"
 	"time"
 
@@ -10,6 +11,8 @@ import (
 )
 
 type DB struct {
+	Location *time.Location // timezone of the agencies
+
 	// basic indexes
 	Routes             *Index[model.Route]
 	ServiceExeceptions *ServiceExceptionIndex // lookup by serviceId and time
@@ -21,6 +24,11 @@ type DB struct {
 
 	// inverted indexes
 	StopTimesByTrip *InvertedIndex[model.StopTime]
+	StopsByParent   *InvertedIndex[model.Stop] // platforms by station id
+
+	// transfer indexes
+	Transfers *TransferIndex // transfer rules between stops
+	Pathways  *PathwayIndex  // walking times inside stations
 
 	// specialized indexes
 	*StopRouteIndex    // get routes by stop id
@@ -31,7 +39,20 @@ type DB struct {
 }
 
 func NewDB(dataset *model.Dataset) *DB {
+	return newDB(dataset, &buildIndexes{})
+}
+
+// the indexes that are slowest to create are built from the dataset or restored from a snapshot
+type slowIndexes interface {
+	stopTimesByTrip(dataset *model.Dataset) *InvertedIndex[model.StopTime]
+	stopRoutes(dataset *model.Dataset, trips *Index[model.Trip]) *StopRouteIndex
+	schedule(dataset *model.Dataset, indexes *indexesRequiredBySchedule) *ScheduleIndex
+	reach(dataset *model.Dataset, trips *Index[model.Trip], stops *Index[model.Stop], stopRoutes *StopRouteIndex, stopTimesByTrip *InvertedIndex[model.StopTime], indexes *indexesRequiredBySchedule) *ReachIndex
+}
+
+func newDB(dataset *model.Dataset, slow slowIndexes) *DB {
 	t0 := time.Now()
+	location := agencyLocation(dataset.Agencies)
 
 	// GTFS indexes
 	routes := NewIndex("routes", dataset.Routes, func(route model.Route) string {
@@ -53,20 +74,30 @@ func NewDB(dataset *model.Dataset) *DB {
 		return trip.ID()
 	})
 
-	stopRoutesIndex := NewStopRouteIndex(trips, dataset.StopTimes)
+	stopRoutesIndex := slow.stopRoutes(dataset, trips)
 
-	stopTimesByTrip := NewInvertedIndex("stop-times-by-trip", dataset.StopTimes, func(record model.StopTime) (key string) {
-		return record.TripId
+	stopTimesByTrip := slow.stopTimesByTrip(dataset)
+
+	platforms := []model.Stop{}
+	for _, stop := range dataset.Stops {
+		if stop.Parent != "" {
+			platforms = append(platforms, stop)
+		}
+	}
+
+	stopsByParent := NewInvertedIndex("stops-by-parent", platforms, func(record model.Stop) (key string) {
+		return record.Parent
 	})
 
 	shapes := NewInvertedIndex("shapes", dataset.Shapes, func(record model.Shape) (key string) {
 		return record.ID()
 	})
 
-	scheduleIndex := NewScheduleIndex(dataset.StopTimes, &indexesRequiredBySchedule{
+	scheduleIndex := slow.schedule(dataset, &indexesRequiredBySchedule{
 		trips:             trips,
 		services:          services,
 		serviceExceptions: serviceExeceptions,
+		location:          location,
 	})
 
 	stopsByCode := NewInvertedIndex("stops-by-code", dataset.Stops, func(stop model.Stop) (key string) {
@@ -74,6 +105,8 @@ func NewDB(dataset *model.Dataset) *DB {
 	})
 
 	database := &DB{
+		Location: location,
+
 		// GTFS indexes
 		Routes:             routes,
 		ServiceExeceptions: serviceExeceptions,
@@ -84,62 +117,170 @@ func NewDB(dataset *model.Dataset) *DB {
 		Shapes:             shapes,
 
 		StopTimesByTrip: stopTimesByTrip,
+		StopsByParent:   stopsByParent,
 
 		// specialized indexes
+		Transfers:      NewTransferIndex(dataset.Transfers, stops, trips),
+		Pathways:       NewPathwayIndex(dataset.Pathways, stopsByParent),
 		StopRouteIndex: stopRoutesIndex,
 		ScheduleIndex:  scheduleIndex,
 		StopLocationIndex: NewStopLocationIndex(dataset.Stops, Resolution{
 			Level:      9,
 			EdgeLength: 174.375668,
 		}),
-		StopTextIndex: NewStopTextIndex(stopsByCode, stopRoutesIndex, dataset.Stops),
-		ReachIndex: NewReachIndex(
+		StopTextIndex: NewStopTextIndex(stopsByCode, stopRoutesIndex, stops, stopsByParent, dataset.Stops),
+		ReachIndex: slow.reach(
+			dataset,
 			trips,
 			stops,
-			dataset.Stops,
 			stopRoutesIndex,
-			dataset.Trips,
 			stopTimesByTrip,
 			scheduleIndex.indexesRequiredBySchedule,
 		),
 	}
 
-	log.Info().Dur("duration", time.Since(t0)).Msg("setup DB")
+	log.Info().Dur("duration", time.Since(t0)).Str("location", location.String()).Msg("setup DB")
 	return database
 }
 
-func NewDBFromFilesystem(path string) (*DB, *model.Dataset) {
-	// input
-	input, err := gtfs.FileInput(path)
-	if err != nil {
-		panic(err)
+// build the slow indexes from the dataset
+type buildIndexes struct{}
+
+func (b *buildIndexes) stopTimesByTrip(dataset *model.Dataset) *InvertedIndex[model.StopTime] {
+	return NewInvertedIndex("stop-times-by-trip", dataset.StopTimes, func(record model.StopTime) (key string) {
+		return record.TripId
+	})
+}
+
+func (b *buildIndexes) stopRoutes(dataset *model.Dataset, trips *Index[model.Trip]) *StopRouteIndex {
+	return NewStopRouteIndex(trips, dataset.StopTimes)
+}
+
+func (b *buildIndexes) schedule(dataset *model.Dataset, indexes *indexesRequiredBySchedule) *ScheduleIndex {
+	return NewScheduleIndex(dataset.StopTimes, indexes)
+}
+
+func (b *buildIndexes) reach(dataset *model.Dataset, trips *Index[model.Trip], stops *Index[model.Stop], stopRoutes *StopRouteIndex, stopTimesByTrip *InvertedIndex[model.StopTime], indexes *indexesRequiredBySchedule) *ReachIndex {
+	return NewReachIndex(trips, stops, dataset.Stops, stopRoutes, dataset.Trips, stopTimesByTrip, indexes)
+}
+
+// timezone of the agencies. feeds in different timezones are not supported so the first timezone is used
+func agencyLocation(agencies []model.Agency) *time.Location {
+	if len(agencies) == 0 {
+		log.Warn().Msg("no agencies, using the local timezone")
+		return time.Local
 	}
 
-	// read the dataset
-	reader := &gtfs.CSVReader{}
-	raw, err := reader.ReadDataset(input)
+	location, err := time.LoadLocation(agencies[0].Timezone)
 	if err != nil {
 		panic(err)
 	}
 
-	tz, err := time.LoadLocation("America/Montreal")
+	for _, agency := range agencies[1:] {
+		if agency.Timezone != agencies[0].Timezone {
+			log.Warn().
+				Str("agency", agency.Name).
+				Str("timezone", agency.Timezone).
+				Str("using", agencies[0].Timezone).
+				Msg("agency has a different timezone")
+		}
+	}
+
+	return location
+}
+
+// Feed is a GTFS feed loaded alongside other feeds
+type Feed struct {
+	Prefix string // prefix added to the feed's stop, route, trip, service and shape IDs. "sto:"
+	Agency string // agency of the feed's stops and routes
+	Path   string // directory or .zip archive containing the GTFS files
+	Filter FeedFilter
+}
+
+// FeedFilter selects the parts of a feed to load. the zero value keeps every service that has not ended
+type FeedFilter struct {
+	Start    time.Time // first date of the services to keep, defaults to today in the timezone of the feed
+	End      time.Time // last date of the services to keep, no limit when zero
+	Routes   []string  // IDs of the routes to keep including the feed prefix, every route when empty
+	Agencies []string  // names or IDs including the feed prefix of the agencies to keep, every agency when empty
+}
+
+func (f FeedFilter) parserFilter() gtfs.ParserFilter {
+	filters := []gtfs.ParserFilter{gtfs.NewWindowFilter(f.Start, f.End)}
+	if len(f.Routes) > 0 {
+		filters = append(filters, gtfs.NewRouteFilter(f.Routes))
+	}
+	if len(f.Agencies) > 0 {
+		filters = append(filters, gtfs.NewAgencyFilter(f.Agencies))
+	}
+	return gtfs.And(filters...)
+}
+
+// NewDBFromFilesystem reads a GTFS feed from a directory or a .zip archive. panics when the feed can't be read
+func NewDBFromFilesystem(path string) (*DB, *model.Dataset) {
+	database, dataset, err := NewDBFromFeeds([]Feed{{Path: path}})
 	if err != nil {
 		panic(err)
 	}
+	return database, dataset
+}
 
-	// parse the dataset
-	parser := &gtfs.CSVParser{
-		ParserFilter: gtfs.NewCutoffFilter(time.Now()),
-		TZ:           tz,
-		TimeLayout:   "15:04:05",
-		DateLayout:   "20060102",
+/* NewDBFromFeeds
+reads and merges several GTFS feeds. the prefixes keep the IDs of different
+feeds from colliding so they must be unique. stops from every feed share the
+location index so trips can transfer between agencies by walking
+*/
+func NewDBFromFeeds(feeds []Feed) (*DB, *model.Dataset, error) {
+	prefixes := map[string]struct{}{}
+	dataset := &model.Dataset{}
+
+	for _, feed := range feeds {
+		if _, ok := prefixes[feed.Prefix]; ok {
+			return nil, nil, fmt.Errorf("feed %s has the same prefix %q as another feed", feed.Path, feed.Prefix)
+		}
+		prefixes[feed.Prefix] = struct{}{}
+
+		feedDataset, err := readFeed(feed)
+		if err != nil {
+			return nil, nil, err
+		}
+		dataset.Merge(feedDataset)
+		runtime.GC()
 	}
 
-	dataset := parser.ParseDataset(raw)
+	// records of filtered trips would still show up in search and planning
+	prune(dataset)
 
 	// indexes
 	database := NewDB(dataset)
 	runtime.GC()
 
-	return database, dataset
+	return database, dataset, nil
+}
+
+// read the feed keeping the records of its filter
+func readFeed(feed Feed) (*model.Dataset, error) {
+	log.Info().Str("path", feed.Path).Str("prefix", feed.Prefix).Str("agency", feed.Agency).Msg("reading GTFS feed")
+
+	// input
+	input, err := gtfs.OpenInput(feed.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open feed %s: %w", feed.Path, err)
+	}
+
+	// parse the dataset while reading it. the timezone and agency default to agency.txt
+	parser := &gtfs.CSVParser{
+		ParserFilter: feed.Filter.parserFilter(),
+		Prefix:       feed.Prefix,
+		Agency:       feed.Agency,
+		TimeLayout:   "15:04:05",
+		DateLayout:   "20060102",
+	}
+
+	dataset, err := parser.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feed %s: %w", feed.Path, err)
+	}
+
+	return dataset, nil
 }
@@ -4,53 +4,60 @@ package gtfs
 
 // Route
 type Route struct {
-	ID        string `csv:"route_id"`
+	ID        string `csv:"route_id,intern"`
+	AgencyID  string `csv:"agency_id,intern"`
 	ShortName string `csv:"route_short_name"`
 	LongName  string `csv:"route_long_name"`
 	Type      int    `csv:"route_type"`
-	Desc      string `csv:"route_url"`
-	URL       string `csv:"route_desc"`
+	Desc      string `csv:"route_desc"`
+	URL       string `csv:"route_url"`
 	Color     string `csv:"route_color"`
 	TextColor string `csv:"route_text_color"`
 }
 
 // Trip
 type Trip struct {
-	ID          string `csv:"trip_id"`
+	ID          string `csv:"trip_id,intern"`
 	Name        string `csv:"trip_short_name"`
-	RouteID     string `csv:"route_id"`
-	ServiceID   string `csv:"service_id"`
-	ShapeID     string `csv:"shape_id"`
+	RouteID     string `csv:"route_id,intern"`
+	ServiceID   string `csv:"service_id,intern"`
+	ShapeID     string `csv:"shape_id,intern"`
 	DirectionID string `csv:"direction_id"`
 	Headsign    string `csv:"trip_headsign"`
+	Wheelchair  int    `csv:"wheelchair_accessible"`
+	Bikes       int    `csv:"bikes_allowed"`
 }
 
 // Stop
 type Stop struct {
-	ID          string  `csv:"stop_id"`
+	ID          string  `csv:"stop_id,intern"`
 	Code        string  `csv:"stop_code"`
 	Name        string  `csv:"stop_name"`
 	Description string  `csv:"stop_desc"`
 	Latitude    float64 `csv:"stop_lat"`
 	Longitude   float64 `csv:"stop_lon"`
 	Type        string  `csv:"location_type"`
-	Parent      string  `csv:"parent_station"`
+	Parent      string  `csv:"parent_station,intern"`
+	Wheelchair  int     `csv:"wheelchair_boarding"`
 }
 
 // StopTime
 type StopTime struct {
-	StopID       string  `csv:"stop_id"`
+	StopID       string  `csv:"stop_id,intern"`
 	StopSeq      string  `csv:"stop_sequence"`
 	StopHeadSign string  `csv:"stop_headsign"`
-	TripID       string  `csv:"trip_id"`
+	TripID       string  `csv:"trip_id,intern"`
 	Shape        float64 `csv:"shape_dist_traveled"`
 	Departure    string  `csv:"departure_time"`
 	Arrival      string  `csv:"arrival_time"`
+	Pickup       int     `csv:"pickup_type"`
+	DropOff      int     `csv:"drop_off_type"`
+	Timepoint    string  `csv:"timepoint"` // exact times when empty
 }
 
 // Calendar
 type Calendar struct {
-	ServiceID string `csv:"service_id"`
+	ServiceID string `csv:"service_id,intern"`
 	Monday    int    `csv:"monday"`
 	Tuesday   int    `csv:"tuesday"`
 	Wednesday int    `csv:"wednesday"`
@@ -64,14 +71,54 @@ type Calendar struct {
 
 // CalendarDate
 type CalendarDate struct {
-	ServiceID     string `csv:"service_id"`
+	ServiceID     string `csv:"service_id,intern"`
 	Date          string `csv:"date"`
 	ExceptionType int    `csv:"exception_type"`
 }
 
+// Agency
+type Agency struct {
+	ID       string `csv:"agency_id,intern"`
+	Name     string `csv:"agency_name"`
+	URL      string `csv:"agency_url"`
+	Timezone string `csv:"agency_timezone"`
+}
+
+// Frequency
+type Frequency struct {
+	TripID     string `csv:"trip_id,intern"`
+	Start      string `csv:"start_time"`
+	End        string `csv:"end_time"`
+	Headway    int    `csv:"headway_secs"`
+	ExactTimes int    `csv:"exact_times"`
+}
+
+// Transfer
+type Transfer struct {
+	FromStopID  string `csv:"from_stop_id,intern"`
+	ToStopID    string `csv:"to_stop_id,intern"`
+	FromRouteID string `csv:"from_route_id,intern"`
+	ToRouteID   string `csv:"to_route_id,intern"`
+	FromTripID  string `csv:"from_trip_id,intern"`
+	ToTripID    string `csv:"to_trip_id,intern"`
+	Type        int    `csv:"transfer_type"`
+	MinTime     int    `csv:"min_transfer_time"`
+}
+
+// Pathway
+type Pathway struct {
+	ID            string  `csv:"pathway_id,intern"`
+	FromStopID    string  `csv:"from_stop_id,intern"`
+	ToStopID      string  `csv:"to_stop_id,intern"`
+	Mode          int     `csv:"pathway_mode"`
+	Bidirectional int     `csv:"is_bidirectional"`
+	Length        float64 `csv:"length"`
+	TraversalTime int     `csv:"traversal_time"`
+}
+
 // Shape
 type Shape struct {
-	ID        string  `csv:"shape_id"`
+	ID        string  `csv:"shape_id,intern"`
 	Latitude  float64 `csv:"shape_pt_lat"`
 	Longitude float64 `csv:"shape_pt_lon"`
 	Seq       int     `csv:"shape_pt_sequence"`
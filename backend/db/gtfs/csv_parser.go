@@ -1,6 +1,7 @@
 package gtfs
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -11,75 +12,223 @@ import (
 
 type CSVParser struct {
 	ParserFilter
-	TZ         *time.Location
+	Prefix     string         // prefix added to stop, route, trip, service and shape IDs
+	Agency     string         // agency of the stops and routes, defaults to the first agency_name
+	TZ         *time.Location // defaults to agency_timezone
 	TimeLayout string
 	DateLayout string
+
+	strings  interner // strings shared by the records while parsing
+	agencies []Agency // agencies of the routes while parsing
 }
 
-func (p *CSVParser) ParseDataset(dataset *CSVDataset) *model.Dataset {
+/* Parse
+streams the GTFS files one record at a time. each record is parsed and filtered before the next
+is read so only the records kept by the ParserFilter are held in memory. files are read in the
+order the filter depends on: services, routes, trips and then stop times
+*/
+func (p *CSVParser) Parse(input *Input) (*model.Dataset, error) {
 	t0 := time.Now()
+	defer input.Close()
+
+	p.strings = interner{}
+	defer func() { p.strings = nil }()
+
+	// with a prefix the IDs of the cells are only copied, id interns them with their prefix
+	cells := p.strings
+	if p.Prefix != "" {
+		cells = nil
+	}
+
+	// records with cells that are not numbers are skipped like malformed records
+	invalid := 0
+	skipInvalid := func(name string) invalidRecord {
+		return func(line int, err error) bool {
+			invalid++
+			log.Warn().Err(err).Str("file", name).Int("line", line).Msg("skipped invalid record")
+			return false
+		}
+	}
+
+	// create the agencies
+	rawAgencies, _, err := read[Agency]("agency.txt", input.Agencies, skipInvalid("agency.txt"))
+	if err != nil {
+		return nil, err
+	}
+
+	if p.TZ == nil {
+		if p.TZ, err = agencyLocation(rawAgencies); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.Agency == "" && len(rawAgencies) > 0 {
+		p.Agency = rawAgencies[0].Name
+	}
+
+	p.agencies = rawAgencies
+	defer func() { p.agencies = nil }()
+
+	agencies := []model.Agency{}
+	for _, agencyRecord := range rawAgencies {
+		agencies = append(agencies, p.parseAgency(agencyRecord))
+	}
+
+	// parse the service exceptions first, services without a calendar are created from them
+	parsedExceptions := []model.ServiceException{}
+	err = stream("calendar_dates.txt", input.CalendarDates, cells, skipInvalid("calendar_dates.txt"), func(calendarDate CalendarDate) {
+		serviceException, err := p.parseServiceException(calendarDate)
+		if err != nil {
+			log.Warn().Err(err).Str("service-id", calendarDate.ServiceID).Msg("skipped malformed service exception")
+			return
+		}
+		parsedExceptions = append(parsedExceptions, serviceException)
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	// create the services
-	services := []model.Service{}
-	for _, calendar := range dataset.Calendars {
-		service := p.parseService(calendar)
-		if !p.FilterService(service) {
-			services = append(services, service)
+	parsedServices := []model.Service{}
+	err = stream("calendar.txt", input.Calendars, cells, skipInvalid("calendar.txt"), func(calendar Calendar) {
+		service, err := p.parseService(calendar)
+		if err != nil {
+			log.Warn().Err(err).Str("service-id", calendar.ServiceID).Msg("skipped malformed service")
+			return
 		}
+		parsedServices = append(parsedServices, service)
+	})
+	if err != nil {
+		return nil, err
 	}
+	parsedServices, synthesized := p.synthesizeServices(parsedServices, parsedExceptions)
 
-	// create service exceptions
+	// the filters see the added dates before deciding on the services
 	serviceExceptions := []model.ServiceException{}
-	for _, calendarDate := range dataset.CalendarDates {
-		serviceException := p.parseServiceException(calendarDate)
+	for _, serviceException := range parsedExceptions {
 		if !p.FilterServiceException(serviceException) {
 			serviceExceptions = append(serviceExceptions, serviceException)
 		}
 	}
 
-	// create trips
-	trips := []model.Trip{}
-	for _, tripRecord := range dataset.Trips {
-		trip := p.parseTrip(tripRecord)
-		if !p.FilterTrip(trip) {
-			trips = append(trips, trip)
+	services := []model.Service{}
+	keptServices := map[string]struct{}{}
+	for _, service := range parsedServices {
+		if !p.FilterService(service) {
+			services = append(services, service)
+			keptServices[service.Id] = struct{}{}
+		}
+	}
+
+	// remove the exceptions of the filtered services
+	kept := serviceExceptions[:0]
+	for _, serviceException := range serviceExceptions {
+		if _, ok := keptServices[serviceException.ServiceId]; ok {
+			kept = append(kept, serviceException)
 		}
 	}
+	serviceExceptions = kept
 
 	// create routes
 	routes := []model.Route{}
-	for _, routeRecord := range dataset.Routes {
+	err = stream("routes.txt", input.Routes, cells, skipInvalid("routes.txt"), func(routeRecord Route) {
 		route := p.parseRoute(routeRecord)
 		if !p.FilterRoute(route) {
 			routes = append(routes, route)
 		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// create trips
+	trips := []model.Trip{}
+	err = stream("trips.txt", input.Trips, cells, skipInvalid("trips.txt"), func(tripRecord Trip) {
+		trip := p.parseTrip(tripRecord)
+		if !p.FilterTrip(trip) {
+			trips = append(trips, trip)
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// create stop times
 	stoptimes := []model.StopTime{}
-	for _, stoptimeRecord := range dataset.StopTimes {
-		stoptime := p.parseStopTime(stoptimeRecord)
+	untimed := map[int]struct{}{} // indexes of stop times without an arrival or departure time
+	malformed := 0
+	err = stream("stop_times.txt", input.Stoptimes, cells, skipInvalid("stop_times.txt"), func(stoptimeRecord StopTime) {
+		stoptime, timed, err := p.parseStopTime(stoptimeRecord)
+		if err != nil {
+			malformed++
+			log.Warn().Err(err).
+				Str("trip-id", stoptimeRecord.TripID).
+				Str("stop-id", stoptimeRecord.StopID).
+				Str("stop-sequence", stoptimeRecord.StopSeq).
+				Msg("skipped malformed stop time")
+			return
+		}
+
 		if !p.FilterStopTime(stoptime) {
+			if !timed {
+				untimed[len(stoptimes)] = struct{}{}
+			}
 			stoptimes = append(stoptimes, stoptime)
 		}
+	})
+	if err != nil {
+		return nil, err
 	}
+	stoptimes = p.interpolateStopTimes(stoptimes, untimed)
+
+	// create a trip for every departure of frequency-based trips
+	frequencies, _, err := read[Frequency]("frequencies.txt", input.Frequencies, skipInvalid("frequencies.txt"))
+	if err != nil {
+		return nil, err
+	}
+	trips, stoptimes = p.expandFrequencies(trips, stoptimes, frequencies)
 
 	// create stops
 	stops := []model.Stop{}
-	for _, stopRecord := range dataset.Stops {
+	err = stream("stops.txt", input.Stops, cells, skipInvalid("stops.txt"), func(stopRecord Stop) {
 		stop := p.parseStop(stopRecord)
 		if !p.FilterStop(stop) {
 			stops = append(stops, stop)
 		}
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	inheritWheelchairBoarding(stops)
+
 	// create shapes
 	shapes := []model.Shape{}
-	for _, shapeRecord := range dataset.Shapes {
+	err = stream("shapes.txt", input.Shapes, cells, skipInvalid("shapes.txt"), func(shapeRecord Shape) {
 		shape := p.parseShape(shapeRecord)
 		if !p.FilterShape(shape) {
 			shapes = append(shapes, shape)
 		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// create transfers and pathways
+	transfers := []model.Transfer{}
+	err = stream("transfers.txt", input.Transfers, cells, skipInvalid("transfers.txt"), func(transferRecord Transfer) {
+		transfers = append(transfers, p.parseTransfer(transferRecord))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pathways := []model.Pathway{}
+	err = stream("pathways.txt", input.Pathways, cells, skipInvalid("pathways.txt"), func(pathwayRecord Pathway) {
+		pathways = append(pathways, p.parsePathway(pathwayRecord))
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	log.Info().
@@ -87,13 +236,20 @@ func (p *CSVParser) ParseDataset(dataset *CSVDataset) *model.Dataset {
 		Int("routes", len(routes)).
 		Int("stops", len(stops)).
 		Int("stoptimes", len(stoptimes)).
+		Int("stoptimes-malformed", malformed).
+		Int("stoptimes-interpolated", len(untimed)).
 		Int("trips", len(trips)).
 		Int("services", len(services)).
+		Int("services-synthesized", synthesized).
 		Int("service-exceptions", len(serviceExceptions)).
 		Int("shapes", len(shapes)).
-		Msg("parsed CSV dataset")
+		Int("transfers", len(transfers)).
+		Int("pathways", len(pathways)).
+		Int("records-invalid", invalid).
+		Msg("parsed GTFS dataset")
 
 	return &model.Dataset{
+		Agencies:          agencies,
 		Routes:            routes,
 		Stops:             stops,
 		StopTimes:         stoptimes,
@@ -101,15 +257,24 @@ func (p *CSVParser) ParseDataset(dataset *CSVDataset) *model.Dataset {
 		Services:          services,
 		ServiceExceptions: serviceExceptions,
 		Shapes:            shapes,
-	}
+		Transfers:         transfers,
+		Pathways:          pathways,
+	}, nil
 }
 
-func (p *CSVParser) parseService(data Calendar) model.Service {
-	start, _ := time.ParseInLocation(p.DateLayout, data.Start, p.TZ)
-	end, _ := time.ParseInLocation(p.DateLayout, data.End, p.TZ)
+func (p *CSVParser) parseService(data Calendar) (model.Service, error) {
+	start, err := time.ParseInLocation(p.DateLayout, data.Start, p.TZ)
+	if err != nil {
+		return model.Service{}, fmt.Errorf("invalid start_date %q", data.Start)
+	}
+
+	end, err := time.ParseInLocation(p.DateLayout, data.End, p.TZ)
+	if err != nil {
+		return model.Service{}, fmt.Errorf("invalid end_date %q", data.End)
+	}
 
 	return model.Service{
-		Id: data.ServiceID,
+		Id: p.id(data.ServiceID),
 		On: [7]bool{
 			data.Sunday == 1,
 			data.Monday == 1,
@@ -121,54 +286,164 @@ func (p *CSVParser) parseService(data Calendar) model.Service {
 		},
 		Start: start,
 		End:   end,
+	}, nil
+}
+
+/* synthesizeServices
+creates a service for each service id that is only in calendar_dates.txt. the service
+does not run on any week day and covers the first to last date of its exceptions
+so it only runs on the dates added by exceptions
+*/
+func (p *CSVParser) synthesizeServices(services []model.Service, exceptions []model.ServiceException) ([]model.Service, int) {
+	existing := map[string]struct{}{}
+	for _, service := range services {
+		existing[service.Id] = struct{}{}
+	}
+
+	synthesized := map[string]*model.Service{}
+	order := []string{}
+
+	for _, exception := range exceptions {
+		if _, ok := existing[exception.ServiceId]; ok {
+			continue
+		}
+
+		service, ok := synthesized[exception.ServiceId]
+		if !ok {
+			service = &model.Service{
+				Id:    exception.ServiceId,
+				Start: exception.Date,
+				End:   exception.Date,
+			}
+			synthesized[exception.ServiceId] = service
+			order = append(order, exception.ServiceId)
+		}
+
+		if exception.Date.Before(service.Start) {
+			service.Start = exception.Date
+		}
+		if exception.Date.After(service.End) {
+			service.End = exception.Date
+		}
 	}
+
+	for _, id := range order {
+		services = append(services, *synthesized[id])
+	}
+
+	return services, len(order)
 }
 
-func (p *CSVParser) parseServiceException(data CalendarDate) model.ServiceException {
-	date, _ := time.ParseInLocation(p.DateLayout, data.Date, p.TZ)
+func (p *CSVParser) parseServiceException(data CalendarDate) (model.ServiceException, error) {
+	date, err := time.ParseInLocation(p.DateLayout, data.Date, p.TZ)
+	if err != nil {
+		return model.ServiceException{}, fmt.Errorf("invalid date %q", data.Date)
+	}
 
 	return model.ServiceException{
-		ServiceId: data.ServiceID,
+		ServiceId: p.id(data.ServiceID),
 		Date:      date,
 		Added:     data.ExceptionType == 1,
+	}, nil
+}
+
+func (p *CSVParser) parseAgency(data Agency) model.Agency {
+	return model.Agency{
+		Id:       p.id(data.ID),
+		Name:     data.Name,
+		URL:      data.URL,
+		Timezone: strings.TrimSpace(data.Timezone),
 	}
 }
 
 func (p *CSVParser) parseRoute(data Route) model.Route {
+	// agency_id is optional when the feed has one agency
+	agencyId, agency := data.AgencyID, p.Agency
+	if agencyId == "" && len(p.agencies) > 0 {
+		agencyId = p.agencies[0].ID
+	}
+
+	// routes of feeds with several agencies are named after their own agency
+	if len(p.agencies) > 1 {
+		for _, agencyRecord := range p.agencies {
+			if agencyRecord.ID == agencyId {
+				agency = agencyRecord.Name
+			}
+		}
+	}
+
 	return model.Route{
-		Id:              data.ID,
+		Id:              p.id(data.ID),
+		AgencyId:        p.id(agencyId),
+		Agency:          agency,
 		Name:            data.ShortName,
+		LongName:        data.LongName,
+		Description:     data.Desc,
+		URL:             data.URL,
+		Type:            data.Type,
 		BackgroundColor: "#" + data.Color,
 		TextColor:       "#" + data.TextColor,
 	}
 }
 
-func (p *CSVParser) parseStopTime(data StopTime) model.StopTime {
-	seq, _ := strconv.Atoi(data.StopSeq)
+// parse a stop time. timed is false when the arrival and departure times need to be interpolated
+func (p *CSVParser) parseStopTime(data StopTime) (stoptime model.StopTime, timed bool, err error) {
+	seq, err := strconv.Atoi(strings.TrimSpace(data.StopSeq))
+	if err != nil {
+		return model.StopTime{}, false, fmt.Errorf("invalid stop_sequence %q", data.StopSeq)
+	}
 
-	hours, _ := strconv.Atoi(data.Departure[0:2])
-	overflow := hours >= 24
-	hours %= 24
+	stoptime = model.StopTime{
+		StopId:      p.id(data.StopID),
+		Sequence:    seq,
+		TripId:      p.id(data.TripID),
+		Headsign:    data.StopHeadSign,
+		Pickup:      model.StopType(data.Pickup),
+		DropOff:     model.StopType(data.DropOff),
+		Approximate: strings.TrimSpace(data.Timepoint) == "0",
+	}
 
-	minutes, _ := strconv.Atoi(data.Departure[3:5])
+	arrival := strings.TrimSpace(data.Arrival)
+	departure := strings.TrimSpace(data.Departure)
 
-	t := model.NewTime(hours, minutes)
+	if arrival == "" && departure == "" {
+		// interpolated times are approximate
+		stoptime.Approximate = true
+		return stoptime, false, nil
+	}
 
-	return model.StopTime{
-		StopId:   data.StopID,
-		Sequence: seq,
-		TripId:   data.TripID,
-		Time:     t,
-		Overflow: overflow,
+	// GTFS allows either time to be omitted when they are the same
+	if arrival == "" {
+		arrival = departure
 	}
+	if departure == "" {
+		departure = arrival
+	}
+
+	if stoptime.Arrival, err = parseTime(arrival); err != nil {
+		return model.StopTime{}, false, err
+	}
+
+	if stoptime.Departure, err = parseTime(departure); err != nil {
+		return model.StopTime{}, false, err
+	}
+
+	if stoptime.Departure < stoptime.Arrival {
+		return model.StopTime{}, false, fmt.Errorf("departure_time %q is before arrival_time %q", departure, arrival)
+	}
+
+	return stoptime, true, nil
 }
 
 func (p *CSVParser) parseStop(data Stop) model.Stop {
 	return model.Stop{
-		Id:   data.ID,
-		Code: data.Code,
-		Name: strings.Title(strings.ToLower(data.Name)),
-		Type: data.Type,
+		Id:         p.id(data.ID),
+		Agency:     p.Agency,
+		Code:       data.Code,
+		Name:       strings.Title(strings.ToLower(data.Name)),
+		Type:       strings.TrimSpace(data.Type),
+		Parent:     p.id(data.Parent),
+		Wheelchair: model.Accessibility(data.Wheelchair),
 		Location: model.Location{
 			Latitude:  data.Latitude,
 			Longitude: data.Longitude,
@@ -176,20 +451,39 @@ func (p *CSVParser) parseStop(data Stop) model.Stop {
 	}
 }
 
+// platforms without wheelchair boarding information use the information of their station
+func inheritWheelchairBoarding(stops []model.Stop) {
+	stations := map[string]model.Accessibility{}
+	for _, stop := range stops {
+		if stop.IsStation() {
+			stations[stop.Id] = stop.Wheelchair
+		}
+	}
+
+	for i, stop := range stops {
+		if stop.Parent != "" && stop.Wheelchair == model.AccessibilityUnknown {
+			stops[i].Wheelchair = stations[stop.Parent]
+		}
+	}
+}
+
 func (p *CSVParser) parseTrip(data Trip) model.Trip {
 	return model.Trip{
-		Id:          data.ID,
-		RouteId:     data.RouteID,
-		ServiceId:   data.ServiceID,
-		ShapeId:     data.ShapeID,
+		Id:          p.id(data.ID),
+		RouteId:     p.id(data.RouteID),
+		ServiceId:   p.id(data.ServiceID),
+		ShapeId:     p.id(data.ShapeID),
 		DirectionId: data.DirectionID,
 		Headsign:    data.Headsign,
+		Name:        data.Name,
+		Wheelchair:  model.Accessibility(data.Wheelchair),
+		Bikes:       model.Accessibility(data.Bikes),
 	}
 }
 
 func (p *CSVParser) parseShape(data Shape) model.Shape {
 	return model.Shape{
-		Id: data.ID,
+		Id: p.id(data.ID),
 		Location: model.Location{
 			Latitude:  data.Latitude,
 			Longitude: data.Longitude,
@@ -197,3 +491,40 @@ func (p *CSVParser) parseShape(data Shape) model.Shape {
 		Seq: data.Seq,
 	}
 }
+
+func (p *CSVParser) parseTransfer(data Transfer) model.Transfer {
+	return model.Transfer{
+		From: model.TransferPoint{
+			StopId:  p.id(data.FromStopID),
+			RouteId: p.id(data.FromRouteID),
+			TripId:  p.id(data.FromTripID),
+		},
+		To: model.TransferPoint{
+			StopId:  p.id(data.ToStopID),
+			RouteId: p.id(data.ToRouteID),
+			TripId:  p.id(data.ToTripID),
+		},
+		Type:    model.TransferType(data.Type),
+		MinTime: time.Duration(data.MinTime) * time.Second,
+	}
+}
+
+func (p *CSVParser) parsePathway(data Pathway) model.Pathway {
+	return model.Pathway{
+		Id:            p.id(data.ID),
+		FromStopId:    p.id(data.FromStopID),
+		ToStopId:      p.id(data.ToStopID),
+		Mode:          data.Mode,
+		Bidirectional: data.Bidirectional == 1,
+		Length:        data.Length,
+		TraversalTime: time.Duration(data.TraversalTime) * time.Second,
+	}
+}
+
+// prefix an id from the feed so it is unique across feeds. empty ids such as missing shapes stay empty
+func (p *CSVParser) id(id string) string {
+	if id == "" || p.Prefix == "" {
+		return id
+	}
+	return p.strings.intern(p.Prefix + id)
+}
@@ -0,0 +1,123 @@
+package gtfs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"stop-checker.com/db/model"
+)
+
+type frequency struct {
+	start      model.Time
+	end        model.Time
+	headway    model.Time
+	exactTimes bool
+}
+
+func (p *CSVParser) parseFrequency(data Frequency) (frequency, error) {
+	start, err := parseTime(strings.TrimSpace(data.Start))
+	if err != nil {
+		return frequency{}, err
+	}
+
+	end, err := parseTime(strings.TrimSpace(data.End))
+	if err != nil {
+		return frequency{}, err
+	}
+
+	if data.Headway <= 0 {
+		return frequency{}, fmt.Errorf("invalid headway_secs %d", data.Headway)
+	}
+
+	return frequency{
+		start:      start,
+		end:        end,
+		headway:    model.Time(data.Headway),
+		exactTimes: data.ExactTimes == 1,
+	}, nil
+}
+
+// id of a trip expanded from frequencies.txt, the GTFS trip id and the start time "T1@08:10:00"
+func frequencyTripId(tripId string, start model.Time) string {
+	return fmt.Sprintf("%s@%02d:%02d:%02d", tripId, int(start)/3600, start.Minute(), start.Second())
+}
+
+/* expandFrequencies
+replaces each trip in frequencies.txt with a trip starting every headway between the start and end time.
+the stop times of the original trip are only used for the time between stops.
+trips without exact times (exact_times=0) keep the headway since their departures are estimates
+*/
+func (p *CSVParser) expandFrequencies(trips []model.Trip, stoptimes []model.StopTime, data []Frequency) ([]model.Trip, []model.StopTime) {
+	frequencies := map[string][]frequency{}
+	for _, record := range data {
+		f, err := p.parseFrequency(record)
+		if err != nil {
+			log.Warn().Err(err).Str("trip-id", record.TripID).Msg("skipped malformed frequency")
+			continue
+		}
+		frequencies[p.id(record.TripID)] = append(frequencies[p.id(record.TripID)], f)
+	}
+
+	if len(frequencies) == 0 {
+		return trips, stoptimes
+	}
+
+	// stop times of the frequency-based trips
+	templates := map[string][]model.StopTime{}
+	expandedStopTimes := []model.StopTime{}
+
+	for _, stoptime := range stoptimes {
+		if _, ok := frequencies[stoptime.TripId]; ok {
+			templates[stoptime.TripId] = append(templates[stoptime.TripId], stoptime)
+			continue
+		}
+		expandedStopTimes = append(expandedStopTimes, stoptime)
+	}
+
+	expandedTrips := []model.Trip{}
+	expanded := 0
+
+	for _, trip := range trips {
+		template, ok := templates[trip.Id]
+		if !ok {
+			expandedTrips = append(expandedTrips, trip)
+			continue
+		}
+
+		sort.Slice(template, func(i, j int) bool {
+			return template[i].Sequence < template[j].Sequence
+		})
+		first := template[0].Departure
+
+		for _, f := range frequencies[trip.Id] {
+			for start := f.start; start < f.end; start += f.headway {
+				instance := trip
+				instance.Id = frequencyTripId(trip.Id, start)
+				instance.TemplateId = trip.Id
+				if !f.exactTimes {
+					instance.Headway = time.Duration(f.headway) * time.Second
+				}
+				expandedTrips = append(expandedTrips, instance)
+
+				offset := start - first
+				for _, stoptime := range template {
+					stoptime.TripId = instance.Id
+					stoptime.Arrival += offset
+					stoptime.Departure += offset
+					expandedStopTimes = append(expandedStopTimes, stoptime)
+				}
+				expanded++
+			}
+		}
+	}
+
+	log.Info().
+		Int("frequency-trips", len(templates)).
+		Int("expanded-trips", expanded).
+		Msg("expanded frequency-based trips")
+
+	return expandedTrips, expandedStopTimes
+}
@@ -0,0 +1,160 @@
+package gtfs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+func TestParseTime(t *testing.T) {
+	valid := map[string]model.Time{
+		"5:30:00":  model.NewTime(5, 30, 0),
+		"05:30:15": model.NewTime(5, 30, 15),
+		"25:00:00": model.NewTime(25, 0, 0),
+		"47:10:30": model.NewTime(47, 10, 30),
+	}
+
+	for value, expected := range valid {
+		parsed, err := parseTime(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, parsed, value)
+	}
+
+	for _, value := range []string{"", "5:30", "5:3:00", "05:60:00", "ab:00:00", "-1:00:00"} {
+		_, err := parseTime(value)
+		assert.Error(t, err, value)
+	}
+
+	parsed, _ := parseTime("47:10:30")
+	assert.Equal(t, 1, parsed.Days())
+	assert.Equal(t, 23, parsed.Hour())
+	assert.Equal(t, 10, parsed.Minute())
+	assert.Equal(t, 30, parsed.Second())
+}
+
+func TestParseStopTime(t *testing.T) {
+	parser := &CSVParser{}
+
+	stoptime, timed, err := parser.parseStopTime(StopTime{TripID: "a", StopSeq: "1", Arrival: "23:59:00", Departure: "24:01:30"})
+	assert.NoError(t, err)
+	assert.True(t, timed)
+	assert.Equal(t, model.NewTime(23, 59, 0), stoptime.Arrival)
+	assert.Equal(t, model.NewTime(24, 1, 30), stoptime.Departure)
+
+	stoptime, timed, err = parser.parseStopTime(StopTime{TripID: "a", StopSeq: "2", StopHeadSign: "Downtown"})
+	assert.NoError(t, err)
+	assert.False(t, timed)
+	assert.Equal(t, "Downtown", stoptime.Headsign)
+	assert.True(t, stoptime.Approximate)
+
+	stoptime, _, err = parser.parseStopTime(StopTime{TripID: "a", StopSeq: "3", Arrival: "12:00:00", Pickup: 1, Timepoint: "0"})
+	assert.NoError(t, err)
+	assert.False(t, stoptime.CanBoard())
+	assert.True(t, stoptime.CanAlight())
+	assert.True(t, stoptime.Approximate)
+
+	_, _, err = parser.parseStopTime(StopTime{TripID: "a", StopSeq: "3", Arrival: "12:00"})
+	assert.Error(t, err)
+
+	_, _, err = parser.parseStopTime(StopTime{TripID: "a", StopSeq: "4", Arrival: "12:00:00", Departure: "11:00:00"})
+	assert.Error(t, err)
+}
+
+func TestInterpolateStopTimes(t *testing.T) {
+	parser := &CSVParser{}
+
+	stoptimes := []model.StopTime{
+		{TripId: "a", Sequence: 1, Arrival: model.NewTime(8, 0, 0), Departure: model.NewTime(8, 0, 0)},
+		{TripId: "a", Sequence: 2},
+		{TripId: "a", Sequence: 3},
+		{TripId: "a", Sequence: 4, Arrival: model.NewTime(8, 6, 0), Departure: model.NewTime(8, 6, 0)},
+		{TripId: "a", Sequence: 5},
+		{TripId: "b", Sequence: 1, Arrival: model.NewTime(9, 0, 0), Departure: model.NewTime(9, 0, 0)},
+	}
+
+	interpolated := parser.interpolateStopTimes(stoptimes, map[int]struct{}{1: {}, 2: {}, 4: {}})
+
+	assert.Len(t, interpolated, 5)
+	assert.Equal(t, model.NewTime(8, 2, 0), interpolated[1].Departure)
+	assert.Equal(t, model.NewTime(8, 4, 0), interpolated[2].Arrival)
+	assert.Equal(t, "b", interpolated[4].TripId)
+}
+
+func TestExpandFrequencies(t *testing.T) {
+	parser := &CSVParser{}
+
+	trips := []model.Trip{{Id: "a"}, {Id: "b"}}
+	stoptimes := []model.StopTime{
+		{TripId: "a", Sequence: 2, Arrival: model.NewTime(6, 5, 0), Departure: model.NewTime(6, 5, 0)},
+		{TripId: "a", Sequence: 1, Arrival: model.NewTime(6, 0, 0), Departure: model.NewTime(6, 0, 0)},
+		{TripId: "b", Sequence: 1, Arrival: model.NewTime(7, 0, 0), Departure: model.NewTime(7, 0, 0)},
+	}
+	frequencies := []Frequency{
+		{TripID: "a", Start: "08:00:00", End: "08:30:00", Headway: 600, ExactTimes: 1},
+		{TripID: "a", Start: "17:00:00", End: "17:10:00", Headway: 300},
+	}
+
+	trips, stoptimes = parser.expandFrequencies(trips, stoptimes, frequencies)
+
+	assert.Len(t, trips, 6)
+	assert.Equal(t, "a@08:00:00", trips[0].Id)
+	assert.Equal(t, "a@08:20:00", trips[2].Id)
+	assert.Equal(t, time.Duration(0), trips[0].Headway)
+	assert.Equal(t, "a@17:05:00", trips[4].Id)
+	assert.Equal(t, 5*time.Minute, trips[4].Headway)
+	assert.Equal(t, "b", trips[5].Id)
+
+	assert.Len(t, stoptimes, 11)
+	assert.Equal(t, "b", stoptimes[0].TripId)
+	assert.Equal(t, model.StopTime{TripId: "a@08:10:00", Sequence: 2, Arrival: model.NewTime(8, 15, 0), Departure: model.NewTime(8, 15, 0)}, stoptimes[4])
+}
+
+func TestSynthesizeServices(t *testing.T) {
+	parser := &CSVParser{}
+	date := func(day int) time.Time {
+		return time.Date(2023, 12, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	services := []model.Service{{Id: "WEEK", On: [7]bool{false, true, true, true, true, true, false}}}
+	exceptions := []model.ServiceException{
+		{ServiceId: "WEEK", Date: date(25)},
+		{ServiceId: "HOLIDAY", Date: date(31), Added: true},
+		{ServiceId: "HOLIDAY", Date: date(25), Added: true},
+		{ServiceId: "HOLIDAY", Date: date(26), Added: true},
+	}
+
+	services, synthesized := parser.synthesizeServices(services, exceptions)
+
+	assert.Equal(t, 1, synthesized)
+	assert.Len(t, services, 2)
+	assert.Equal(t, model.Service{Id: "HOLIDAY", Start: date(25), End: date(31)}, services[1])
+}
+
+func TestParserPrefix(t *testing.T) {
+	parser := &CSVParser{Prefix: "sto:", Agency: "STO"}
+
+	trip := parser.parseTrip(Trip{ID: "T1", RouteID: "R1", ServiceID: "WEEK"})
+	assert.Equal(t, model.Trip{Id: "sto:T1", RouteId: "sto:R1", ServiceId: "sto:WEEK"}, trip)
+
+	stop := parser.parseStop(Stop{ID: "A", Code: "1234"})
+	assert.Equal(t, "sto:A", stop.Id)
+	assert.Equal(t, "1234", stop.Code)
+	assert.Equal(t, "STO", stop.Agency)
+}
+
+func TestInheritWheelchairBoarding(t *testing.T) {
+	stops := []model.Stop{
+		{Id: "S", Type: "1", Wheelchair: model.Accessible},
+		{Id: "P1", Parent: "S"},
+		{Id: "P2", Parent: "S", Wheelchair: model.NotAccessible},
+		{Id: "A"},
+	}
+
+	inheritWheelchairBoarding(stops)
+
+	assert.Equal(t, model.Accessible, stops[1].Wheelchair)
+	assert.Equal(t, model.NotAccessible, stops[2].Wheelchair)
+	assert.Equal(t, model.AccessibilityUnknown, stops[3].Wheelchair)
+}
@@ -0,0 +1,120 @@
+package gtfs
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"stop-checker.com/db/model"
+)
+
+/* parseTime
+parses a GTFS time "H:MM:SS" or "HH:MM:SS" measured from the start of the service day.
+hours can exceed 24 when a trip continues past midnight, "47:10:30" is 11:10:30 PM the day after next
+*/
+func parseTime(value string) (model.Time, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid time %q", value)
+	}
+
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil || hours < 0 || len(parts[0]) == 0 {
+		return 0, fmt.Errorf("invalid hours in time %q", value)
+	}
+
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil || minutes < 0 || minutes > 59 || len(parts[1]) != 2 {
+		return 0, fmt.Errorf("invalid minutes in time %q", value)
+	}
+
+	seconds, err := strconv.Atoi(parts[2])
+	if err != nil || seconds < 0 || seconds > 59 || len(parts[2]) != 2 {
+		return 0, fmt.Errorf("invalid seconds in time %q", value)
+	}
+
+	return model.NewTime(hours, minutes, seconds), nil
+}
+
+/* interpolateStopTimes
+stop times without an arrival or departure time are interpolated evenly between the
+timed stop times before and after them on the same trip. untimed stop times at the start or end
+of a trip cannot be interpolated and are removed
+*/
+func (p *CSVParser) interpolateStopTimes(stoptimes []model.StopTime, untimed map[int]struct{}) []model.StopTime {
+	if len(untimed) == 0 {
+		return stoptimes
+	}
+
+	// stop time indexes for each trip that has untimed stop times
+	trips := map[string][]int{}
+	for i := range untimed {
+		trips[stoptimes[i].TripId] = nil
+	}
+
+	for i, stoptime := range stoptimes {
+		if indexes, ok := trips[stoptime.TripId]; ok {
+			trips[stoptime.TripId] = append(indexes, i)
+		}
+	}
+
+	removed := map[int]struct{}{}
+
+	for tripId, indexes := range trips {
+		sort.Slice(indexes, func(i, j int) bool {
+			return stoptimes[indexes[i]].Sequence < stoptimes[indexes[j]].Sequence
+		})
+
+		previous := -1 // position in indexes of the previous timed stop time
+		removedBefore := len(removed)
+
+		for position, i := range indexes {
+			if _, ok := untimed[i]; ok {
+				continue
+			}
+
+			// interpolate the untimed stop times between the previous and current stop time
+			gap := position - previous - 1
+			if previous >= 0 && gap > 0 {
+				start := stoptimes[indexes[previous]].Departure
+				end := stoptimes[i].Arrival
+
+				for k := 1; k <= gap; k++ {
+					t := start + (end-start)*model.Time(k)/model.Time(gap+1)
+					stoptimes[indexes[previous+k]].Arrival = t
+					stoptimes[indexes[previous+k]].Departure = t
+				}
+			} else if gap > 0 {
+				for _, j := range indexes[:position] {
+					removed[j] = struct{}{}
+				}
+			}
+
+			previous = position
+		}
+
+		// untimed stop times at the end of the trip
+		for _, j := range indexes[previous+1:] {
+			removed[j] = struct{}{}
+		}
+
+		if len(removed) > removedBefore {
+			log.Warn().Str("trip-id", tripId).Msg("removed stop times that could not be interpolated")
+		}
+	}
+
+	if len(removed) == 0 {
+		return stoptimes
+	}
+
+	interpolated := make([]model.StopTime, 0, len(stoptimes)-len(removed))
+	for i, stoptime := range stoptimes {
+		if _, ok := removed[i]; !ok {
+			interpolated = append(interpolated, stoptime)
+		}
+	}
+
+	return interpolated
+}
@@ -1,67 +1,211 @@
 package gtfs
 
 import (
+	"encoding/csv"
+	"errors"
+	"fmt"
 	"io"
-	"runtime"
+	"reflect"
+	"strconv"
+	"strings"
 	"time"
-
-	csvtag "github.com/artonge/go-csv-tag/v2"
-	"github.com/rs/zerolog/log"
 )
 
-// Read CSVs
-func read[T any](input io.ReadCloser) []T {
-	data := []T{}
-	if err := csvtag.LoadFromReader(input, &data, csvtag.CsvOptions{Separator: ','}); err != nil {
-		panic(err)
+/* invalidRecord
+is called with the line and the first error of records that have cells that are not numbers. the
+record is passed on with the invalid cells left empty when it returns true, otherwise it's skipped
+*/
+type invalidRecord func(line int, err error) bool
+
+/* stream
+reads a CSV one record at a time into T using the `csv` tags of its fields. columns without
+a field are ignored and fields without a column are left empty. empty numbers are 0.
+the cells of fields tagged `csv:"trip_id,intern"` are interned, other strings are copied.
+each record is passed to the callback and then discarded so whole files are never in memory.
+records with invalid cells don't stop the file from being read, they are passed to invalid.
+optional files that are missing have a nil input and have no records. the input is closed with
+the rest of the feed's files by Input.Close
+*/
+func stream[T any](name string, input io.Reader, interned interner, invalid invalidRecord, each func(record T)) error {
+	return streamLines(name, input, interned, invalid, func(record T, line int) { each(record) })
+}
+
+// stream passing the line each record starts on, the header is line 1
+func streamLines[T any](name string, input io.Reader, interned interner, invalid invalidRecord, each func(record T, line int)) error {
+	if input == nil {
+		return nil
+	}
+
+	reader := csv.NewReader(input)
+	reader.ReuseRecord = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", name, err)
+	}
+
+	var record T
+	value := reflect.ValueOf(&record).Elem()
+	columns := newColumns(value.Type(), header)
+
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", name, err)
+		}
+
+		// quoted cells can span lines and blank lines are skipped so records are found by their position
+		line, _ := reader.FieldPos(0)
+
+		value.Set(reflect.Zero(value.Type()))
+		if err := columns.decode(row, value, interned); err != nil {
+			if !invalid(line, err) {
+				continue
+			}
+		}
+		each(record, line)
+	}
+}
+
+// read every record of a CSV and the line each record starts on
+func read[T any](name string, input io.Reader, invalid invalidRecord) ([]T, []int, error) {
+	data, lines := []T{}, []int{}
+	err := streamLines(name, input, interner{}, invalid, func(record T, line int) {
+		data = append(data, record)
+		lines = append(lines, line)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, lines, nil
+}
+
+/* interner
+shares one copy of repeated strings. trip, stop and service IDs are repeated on
+millions of stop times. the copy also keeps the string from holding on to the
+memory of the whole CSV line it was read from. a nil interner only copies
+*/
+type interner map[string]string
+
+func (i interner) intern(s string) string {
+	if interned, ok := i[s]; ok {
+		return interned
 	}
+	s = string([]byte(s))
+	if i != nil {
+		i[s] = s
+	}
+	return s
+}
+
+// struct field of each column, nil for columns without a field
+type columns []*column
+
+type column struct {
+	name   string
+	index  int
+	kind   reflect.Kind
+	intern bool // the column has IDs repeated across records
+}
 
-	input.Close()
-	runtime.GC() // todo: use parquet if this doesn't work
-	return data
+func newColumns(t reflect.Type, header []string) columns {
+	fields := map[string]column{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if tag := field.Tag.Get("csv"); tag != "" {
+			name, option, _ := strings.Cut(tag, ",")
+			fields[name] = column{name: name, index: i, kind: field.Type.Kind(), intern: option == "intern"}
+		}
+	}
+
+	columns := make(columns, len(header))
+	for i, name := range header {
+		// some feeds start with a byte order mark
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		if field, ok := fields[name]; ok {
+			columns[i] = &field
+		}
+	}
+	return columns
 }
 
-type CSVDataset struct {
-	Calendars     []Calendar
-	CalendarDates []CalendarDate
-	Routes        []Route
-	StopTimes     []StopTime
-	Stops         []Stop
-	Trips         []Trip
-	Shapes        []Shape
+// decode every cell of the row. invalid cells are left empty and the first error is returned
+func (c columns) decode(row []string, record reflect.Value, interned interner) error {
+	var invalid error
+	for i, value := range row {
+		if i >= len(c) || c[i] == nil {
+			continue
+		}
+
+		column := c[i]
+		field := record.Field(column.index)
+
+		switch column.kind {
+		case reflect.String:
+			if column.intern {
+				field.SetString(interned.intern(value))
+			} else {
+				field.SetString(string([]byte(value)))
+			}
+
+		case reflect.Int:
+			n, err := parseNumber(value, strconv.Atoi)
+			if err != nil {
+				if invalid == nil {
+					invalid = fmt.Errorf("invalid %s %q", column.name, value)
+				}
+				continue
+			}
+			field.SetInt(int64(n))
+
+		case reflect.Float64:
+			f, err := parseNumber(value, func(s string) (float64, error) {
+				return strconv.ParseFloat(s, 64)
+			})
+			if err != nil {
+				if invalid == nil {
+					invalid = fmt.Errorf("invalid %s %q", column.name, value)
+				}
+				continue
+			}
+			field.SetFloat(f)
+		}
+	}
+	return invalid
 }
 
-type CSVReader struct {
+// empty numbers are 0
+func parseNumber[N int | float64](value string, parse func(string) (N, error)) (N, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	return parse(value)
 }
 
-func (r *CSVReader) ReadDataset(input *Input) (*CSVDataset, error) {
-	t0 := time.Now()
-	calendars := read[Calendar](input.Calendars)
-	calendarDates := read[CalendarDate](input.CalendarDates)
-	routes := read[Route](input.Routes)
-	stoptimes := read[StopTime](input.Stoptimes)
-	stops := read[Stop](input.Stops)
-	trips := read[Trip](input.Trips)
-	shapes := read[Shape](input.Shapes)
-
-	log.Info().
-		Dur("duration", time.Since(t0)).
-		Int("routes", len(routes)).
-		Int("stops", len(stops)).
-		Int("stoptimes", len(stoptimes)).
-		Int("trips", len(trips)).
-		Int("services", len(calendars)).
-		Int("service-exceptions", len(calendarDates)).
-		Int("shapes", len(shapes)).
-		Msg("read CSV dataset")
-
-	return &CSVDataset{
-		Calendars:     calendars,
-		CalendarDates: calendarDates,
-		Routes:        routes,
-		StopTimes:     stoptimes,
-		Stops:         stops,
-		Trips:         trips,
-		Shapes:        shapes,
-	}, nil
+// location of the feed from agency_timezone. every agency in a feed must have the same timezone
+func agencyLocation(agencies []Agency) (*time.Location, error) {
+	if len(agencies) == 0 {
+		return nil, errors.New("agency.txt has no agencies")
+	}
+
+	name := strings.TrimSpace(agencies[0].Timezone)
+	if name == "" {
+		return nil, errors.New("agency.txt is missing agency_timezone")
+	}
+
+	for _, agency := range agencies[1:] {
+		if strings.TrimSpace(agency.Timezone) != name {
+			return nil, fmt.Errorf("agencies have different timezones %q and %q", name, agency.Timezone)
+		}
+	}
+
+	return time.LoadLocation(name)
 }
@@ -0,0 +1,164 @@
+package gtfs
+
+import (
+	"fmt"
+	"io"
+	"os"
+	fp "path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream(t *testing.T) {
+	data := "\ufefftrip_id,unknown,stop_sequence,shape_dist_traveled,stop_id\n" +
+		"T1,x,1,,A\n" +
+		"T1,x,2,1.5,B\n"
+
+	stoptimes := []StopTime{}
+	interned := interner{}
+	keep := func(line int, err error) bool { return true }
+	err := stream("stop_times.txt", io.NopCloser(strings.NewReader(data)), interned, keep, func(record StopTime) {
+		stoptimes = append(stoptimes, record)
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []StopTime{
+		{TripID: "T1", StopSeq: "1", StopID: "A"},
+		{TripID: "T1", StopSeq: "2", StopID: "B", Shape: 1.5},
+	}, stoptimes)
+
+	// only the IDs are interned
+	assert.Equal(t, interner{"T1": "T1", "A": "A", "B": "B"}, interned)
+
+	// invalid numbers are reported with the line of the record and the file is still read
+	lines := []int{}
+	frequencies, frequencyLines, err := read[Frequency]("frequencies.txt", io.NopCloser(strings.NewReader("trip_id,headway_secs\nT1,300\nT2,five\nT3,600\n")), func(line int, err error) bool {
+		lines = append(lines, line)
+		assert.EqualError(t, err, `invalid headway_secs "five"`)
+		return false
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3}, lines)
+	assert.Equal(t, []Frequency{{TripID: "T1", Headway: 300}, {TripID: "T3", Headway: 600}}, frequencies)
+	assert.Equal(t, []int{2, 4}, frequencyLines)
+}
+
+// counts the times each file is closed
+type closeCounter struct {
+	io.ReadCloser
+	name   string
+	closes map[string]int
+}
+
+func (c *closeCounter) Close() error {
+	c.closes[c.name]++
+	return c.ReadCloser.Close()
+}
+
+func TestParse(t *testing.T) {
+	dir := t.TempDir()
+	writeSyntheticFeed(t, dir, 3, 4)
+
+	closes := map[string]int{}
+	input, missing, err := newInput(func(name string) (io.ReadCloser, error) {
+		file, err := os.Open(fp.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		return &closeCounter{ReadCloser: file, name: name, closes: closes}, nil
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, missing)
+
+	parser := &CSVParser{
+		ParserFilter: &EmptyFilter{},
+		Prefix:       "oc:",
+		TimeLayout:   "15:04:05",
+		DateLayout:   "20060102",
+	}
+	dataset, err := parser.Parse(input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "America/Montreal", parser.TZ.String())
+	assert.Equal(t, "Synthetic Transit", parser.Agency)
+	assert.Len(t, dataset.Trips, 3)
+	assert.Len(t, dataset.StopTimes, 12)
+	assert.Len(t, dataset.Stops, 4)
+	assert.Equal(t, "oc:T0", dataset.StopTimes[0].TripId)
+	assert.Equal(t, "oc:S0", dataset.StopTimes[0].StopId)
+
+	// every file is closed once
+	assert.NotEmpty(t, closes)
+	for name, n := range closes {
+		assert.Equal(t, 1, n, name)
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	dir := b.TempDir()
+	writeSyntheticFeed(b, dir, 20000, 40)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		input, err := FileInput(dir)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		parser := &CSVParser{
+			ParserFilter: NewCutoffFilter(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+			TimeLayout:   "15:04:05",
+			DateLayout:   "20060102",
+		}
+		if _, err := parser.Parse(input); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// a feed with one route where every trip visits the same stops
+func writeSyntheticFeed(t testing.TB, dir string, trips, stops int) {
+	files := map[string]*strings.Builder{}
+	write := func(name, format string, args ...any) {
+		if _, ok := files[name]; !ok {
+			files[name] = &strings.Builder{}
+		}
+		fmt.Fprintf(files[name], format+"\n", args...)
+	}
+
+	write("agency.txt", "agency_id,agency_name,agency_url,agency_timezone")
+	write("agency.txt", "A,Synthetic Transit,https://example.com,America/Montreal")
+	write("calendar.txt", "service_id,monday,tuesday,wednesday,thursday,friday,saturday,sunday,start_date,end_date")
+	write("calendar.txt", "S,1,1,1,1,1,1,1,20220101,20991231")
+	write("calendar_dates.txt", "service_id,date,exception_type")
+	write("routes.txt", "route_id,route_short_name,route_long_name,route_type,route_color,route_text_color")
+	write("routes.txt", "R,1,Synthetic,3,000000,FFFFFF")
+	write("stops.txt", "stop_id,stop_code,stop_name,stop_lat,stop_lon,location_type,parent_station,wheelchair_boarding")
+	write("trips.txt", "route_id,service_id,trip_id,trip_headsign,direction_id,shape_id")
+	write("stop_times.txt", "trip_id,arrival_time,departure_time,stop_id,stop_sequence,pickup_type,drop_off_type")
+
+	for i := 0; i < stops; i++ {
+		write("stops.txt", "S%d,%d,Stop %d,45.%d,-75.%d,0,,1", i, i, i, i, i)
+	}
+
+	for i := 0; i < trips; i++ {
+		write("trips.txt", "R,S,T%d,Downtown,0,", i)
+		start := 5*3600 + (i%1000)*60
+		for j := 0; j < stops; j++ {
+			t := start + j*90
+			time := fmt.Sprintf("%02d:%02d:%02d", t/3600, t/60%60, t%60)
+			write("stop_times.txt", "T%d,%s,%s,S%d,%d,0,0", i, time, time, j, j+1)
+		}
+	}
+
+	for name, data := range files {
+		if err := os.WriteFile(fp.Join(dir, name), []byte(data.String()), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -1,64 +1,237 @@
 package gtfs
 
 import (
+	"archive/zip"
+	"errors"
+	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	fp "path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 type Input struct {
-	Calendars     io.ReadCloser // service
-	CalendarDates io.ReadCloser // service exceptions
+	Agencies      io.ReadCloser
+	Calendars     io.ReadCloser // service, optional when there are calendar dates
+	CalendarDates io.ReadCloser // service exceptions, optional when there is a calendar
 	Routes        io.ReadCloser
 	Stoptimes     io.ReadCloser
 	Stops         io.ReadCloser
 	Trips         io.ReadCloser
-	Shapes        io.ReadCloser
+	Shapes        io.ReadCloser // optional
+	Frequencies   io.ReadCloser // optional
+	Transfers     io.ReadCloser // optional
+	Pathways      io.ReadCloser // optional
 }
 
-func FileInput(path string) (*Input, error) {
-	calendars, err := os.Open(fp.Join(path, "calendar.txt"))
-	if err != nil {
-		return nil, err
+// Close every file of the input, the files are not closed when they are read
+func (input *Input) Close() error {
+	var err error
+	for _, file := range input.files() {
+		if *file.reader == nil {
+			continue
+		}
+		if closeErr := (*file.reader).Close(); err == nil {
+			err = closeErr
+		}
 	}
+	return err
+}
 
-	calendarDates, err := os.Open(fp.Join(path, "calendar_dates.txt"))
-	if err != nil {
-		return nil, err
+type inputFile struct {
+	name     string
+	reader   *io.ReadCloser
+	required bool
+}
+
+func (input *Input) files() []inputFile {
+	return []inputFile{
+		{name: "agency.txt", reader: &input.Agencies, required: true},
+		{name: "calendar.txt", reader: &input.Calendars, required: false},
+		{name: "calendar_dates.txt", reader: &input.CalendarDates, required: false},
+		{name: "routes.txt", reader: &input.Routes, required: true},
+		{name: "stop_times.txt", reader: &input.Stoptimes, required: true},
+		{name: "stops.txt", reader: &input.Stops, required: true},
+		{name: "trips.txt", reader: &input.Trips, required: true},
+		{name: "shapes.txt", reader: &input.Shapes, required: false},
+		{name: "frequencies.txt", reader: &input.Frequencies, required: false},
+		{name: "transfers.txt", reader: &input.Transfers, required: false},
+		{name: "pathways.txt", reader: &input.Pathways, required: false},
 	}
+}
 
-	routes, err := os.Open(fp.Join(path, "routes.txt"))
-	if err != nil {
-		return nil, err
+// MissingFilesError lists the required GTFS files that were not found
+type MissingFilesError struct {
+	Files []string
+}
+
+func (e *MissingFilesError) Error() string {
+	return fmt.Sprintf("missing required GTFS files: %s", strings.Join(e.Files, ", "))
+}
+
+// open a GTFS file by name, "stops.txt", "trips.txt", etc. errors with fs.ErrNotExist when the file is missing
+type opener func(name string) (io.ReadCloser, error)
+
+// open every GTFS file. missing files are left nil and the names of missing required files are returned
+func newInput(open opener) (*Input, []string, error) {
+	input := &Input{}
+	missing := []string{}
+
+	for _, file := range input.files() {
+		reader, err := open(file.name)
+		if errors.Is(err, fs.ErrNotExist) {
+			if file.required {
+				missing = append(missing, file.name)
+			}
+			continue
+		}
+
+		if err != nil {
+			input.Close()
+			return nil, nil, err
+		}
+
+		*file.reader = reader
 	}
 
-	stoptimes, err := os.Open(fp.Join(path, "stop_times.txt"))
-	if err != nil {
-		return nil, err
+	// services can be defined by calendar.txt, calendar_dates.txt or both
+	if input.Calendars == nil && input.CalendarDates == nil {
+		missing = append([]string{"calendar.txt", "calendar_dates.txt"}, missing...)
 	}
 
-	stops, err := os.Open(fp.Join(path, "stops.txt"))
+	return input, missing, nil
+}
+
+func requireFiles(input *Input, missing []string, err error) (*Input, error) {
 	if err != nil {
 		return nil, err
 	}
 
-	trips, err := os.Open(fp.Join(path, "trips.txt"))
+	if len(missing) > 0 {
+		input.Close()
+		return nil, &MissingFilesError{Files: missing}
+	}
+
+	return input, nil
+}
+
+// OpenInput reads the GTFS files from a .zip archive or a directory
+func OpenInput(path string) (*Input, error) {
+	return requireFiles(openInput(path))
+}
+
+// FileInput reads the GTFS files from a directory
+func FileInput(path string) (*Input, error) {
+	return requireFiles(fileInput(path))
+}
+
+// ZipInput reads the GTFS files from a .zip archive. Files are decompressed
+// as they are read and the archive is closed once every file has been closed
+func ZipInput(path string) (*Input, error) {
+	return requireFiles(zipInput(path))
+}
+
+func openInput(path string) (*Input, []string, error) {
+	if strings.EqualFold(fp.Ext(path), ".zip") {
+		return zipInput(path)
+	}
+	return fileInput(path)
+}
+
+func fileInput(path string) (*Input, []string, error) {
+	info, err := os.Stat(path)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if !info.IsDir() {
+		return nil, nil, fmt.Errorf("%s is not a directory or .zip archive", path)
 	}
 
-	shapes, err := os.Open(fp.Join(path, "shapes.txt"))
+	return newInput(func(name string) (io.ReadCloser, error) {
+		return os.Open(fp.Join(path, name))
+	})
+}
+
+func zipInput(path string) (*Input, []string, error) {
+	reader, err := zip.OpenReader(path)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// files by name. some feeds nest the files inside a folder
+	files := map[string]*zip.File{}
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		name := file.Name[strings.LastIndex(file.Name, "/")+1:]
+		files[strings.ToLower(name)] = file
+	}
+
+	archive := &zipArchive{ReadCloser: reader}
+
+	input, missing, err := newInput(func(name string) (io.ReadCloser, error) {
+		file, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("%s not found in %s: %w", name, path, fs.ErrNotExist)
+		}
+
+		member, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		atomic.AddInt32(&archive.refs, 1)
+		return &zipMember{ReadCloser: member, archive: archive}, nil
+	})
+
+	// no members are open when the archive has no GTFS files or failed to open
+	if atomic.LoadInt32(&archive.refs) == 0 {
+		archive.close()
+	}
+
+	return input, missing, err
+}
+
+type zipArchive struct {
+	*zip.ReadCloser
+	refs   int32 // number of open members
+	closer sync.Once
+}
+
+func (a *zipArchive) release() error {
+	if atomic.AddInt32(&a.refs, -1) == 0 {
+		return a.close()
+	}
+	return nil
+}
+
+func (a *zipArchive) close() error {
+	var err error
+	a.closer.Do(func() {
+		err = a.ReadCloser.Close()
+	})
+	return err
+}
+
+type zipMember struct {
+	io.ReadCloser
+	archive *zipArchive
+	closed  int32
+}
+
+func (m *zipMember) Close() error {
+	if !atomic.CompareAndSwapInt32(&m.closed, 0, 1) {
+		return nil
 	}
 
-	return &Input{
-		Calendars:     calendars,
-		CalendarDates: calendarDates,
-		Routes:        routes,
-		Stoptimes:     stoptimes,
-		Stops:         stops,
-		Trips:         trips,
-		Shapes:        shapes,
-	}, nil
+	err := m.ReadCloser.Close()
+	if releaseErr := m.archive.release(); err == nil {
+		err = releaseErr
+	}
+	return err
 }
@@ -0,0 +1,78 @@
+package gtfs
+
+import (
+	"archive/zip"
+	"io"
+	"os"
+	fp "path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var inputFiles = []string{
+	"agency.txt",
+	"calendar.txt",
+	"calendar_dates.txt",
+	"routes.txt",
+	"stop_times.txt",
+	"stops.txt",
+	"trips.txt",
+	"shapes.txt",
+}
+
+func writeZip(t *testing.T, prefix string, files []string) string {
+	filename := fp.Join(t.TempDir(), "gtfs.zip")
+	f, err := os.Create(filename)
+	assert.NoError(t, err)
+
+	w := zip.NewWriter(f)
+	for _, name := range files {
+		member, err := w.Create(prefix + name)
+		assert.NoError(t, err)
+		_, err = member.Write([]byte(name))
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, w.Close())
+	assert.NoError(t, f.Close())
+	return filename
+}
+
+func TestZipInput(t *testing.T) {
+	input, err := OpenInput(writeZip(t, "google_transit/", inputFiles))
+	assert.NoError(t, err)
+
+	data, err := io.ReadAll(input.Stops)
+	assert.NoError(t, err)
+	assert.Equal(t, "stops.txt", string(data))
+
+	for _, member := range []io.ReadCloser{
+		input.Agencies, input.Calendars, input.CalendarDates, input.Routes, input.Stoptimes, input.Stops, input.Trips, input.Shapes,
+	} {
+		assert.NoError(t, member.Close())
+	}
+}
+
+func TestZipInputMissingFile(t *testing.T) {
+	_, err := ZipInput(writeZip(t, "", inputFiles[3:5]))
+
+	missing := &MissingFilesError{}
+	assert.ErrorAs(t, err, &missing)
+	assert.Equal(t, []string{"calendar.txt", "calendar_dates.txt", "agency.txt", "stops.txt", "trips.txt"}, missing.Files)
+}
+
+func TestZipInputCalendarDatesOnly(t *testing.T) {
+	files := append([]string{"agency.txt"}, inputFiles[2:]...)
+	input, err := ZipInput(writeZip(t, "", files))
+	assert.NoError(t, err)
+	assert.Nil(t, input.Calendars)
+	assert.NoError(t, input.Close())
+}
+
+func TestZipInputOptionalFile(t *testing.T) {
+	input, err := ZipInput(writeZip(t, "", inputFiles[:len(inputFiles)-1]))
+	assert.NoError(t, err)
+	assert.Nil(t, input.Shapes)
+	assert.NoError(t, input.Close())
+}
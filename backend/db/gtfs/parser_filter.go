@@ -6,7 +6,10 @@ import (
 	"stop-checker.com/db/model"
 )
 
-// ParserFilter. filter records when true
+/* ParserFilter
+filter records when true. service exceptions are filtered before the services so a service can be kept
+for its added dates, the exceptions of filtered services are removed with them
+*/
 type ParserFilter interface {
 	FilterService(service model.Service) bool
 	FilterServiceException(serviceException model.ServiceException) bool
@@ -48,63 +51,283 @@ func (e *EmptyFilter) FilterShape(shape model.Shape) bool {
 	return false
 }
 
-// CutoffFilter should
-type CutoffFilter struct {
-	cutoff        time.Time
-	validRoutes   map[string]struct{}
+/* And
+keeps the records kept by every filter. every filter sees every record so filters that
+remember the records they keep (trips for their stop times) stay consistent
+*/
+func And(filters ...ParserFilter) ParserFilter {
+	return &combinedFilter{filters: filters, all: true}
+}
+
+// Or keeps the records kept by any of the filters
+func Or(filters ...ParserFilter) ParserFilter {
+	return &combinedFilter{filters: filters, all: false}
+}
+
+type combinedFilter struct {
+	filters []ParserFilter
+	all     bool // records are filtered when any filter filters them, otherwise when every filter does
+}
+
+func (c *combinedFilter) filter(filtered func(filter ParserFilter) bool) bool {
+	some, every := false, true
+	for _, filter := range c.filters {
+		result := filtered(filter)
+		some = some || result
+		every = every && result
+	}
+
+	if c.all {
+		return some
+	}
+	return every
+}
+
+func (c *combinedFilter) FilterService(service model.Service) bool {
+	return c.filter(func(filter ParserFilter) bool { return filter.FilterService(service) })
+}
+
+func (c *combinedFilter) FilterServiceException(serviceException model.ServiceException) bool {
+	return c.filter(func(filter ParserFilter) bool { return filter.FilterServiceException(serviceException) })
+}
+
+func (c *combinedFilter) FilterTrip(trip model.Trip) bool {
+	return c.filter(func(filter ParserFilter) bool { return filter.FilterTrip(trip) })
+}
+
+func (c *combinedFilter) FilterRoute(route model.Route) bool {
+	return c.filter(func(filter ParserFilter) bool { return filter.FilterRoute(route) })
+}
+
+func (c *combinedFilter) FilterStopTime(stoptime model.StopTime) bool {
+	return c.filter(func(filter ParserFilter) bool { return filter.FilterStopTime(stoptime) })
+}
+
+func (c *combinedFilter) FilterStop(stop model.Stop) bool {
+	return c.filter(func(filter ParserFilter) bool { return filter.FilterStop(stop) })
+}
+
+func (c *combinedFilter) FilterShape(shape model.Shape) bool {
+	return c.filter(func(filter ParserFilter) bool { return filter.FilterShape(shape) })
+}
+
+/* WindowFilter
+keeps the services that run on at least one date from start to end, by their calendar or an
+added date, and the trips, stop times and shapes of those services. dates are compared without their time of day. routes and stops
+are kept, the routes without trips are pruned after parsing
+*/
+type WindowFilter struct {
+	start         time.Time // zero to start today in the timezone of the services
+	end           time.Time // zero when the window has no end
 	validTrips    map[string]struct{}
 	validServices map[string]struct{}
 	validShapes   map[string]struct{}
+	addedServices map[string]struct{} // services with an added date in the window
 }
 
-func NewCutoffFilter(cutoff time.Time) *CutoffFilter {
-	return &CutoffFilter{
-		cutoff:        cutoff,
-		validRoutes:   map[string]struct{}{},
+/* NewWindowFilter
+keeps services running from start to end. a zero start keeps the services running from today in the
+timezone of the feed and a zero end keeps every service that has not ended
+*/
+func NewWindowFilter(start, end time.Time) *WindowFilter {
+	filter := &WindowFilter{
 		validTrips:    map[string]struct{}{},
 		validServices: map[string]struct{}{},
 		validShapes:   map[string]struct{}{},
+		addedServices: map[string]struct{}{},
+	}
+	if !start.IsZero() {
+		filter.start = date(start)
+	}
+	if !end.IsZero() {
+		filter.end = date(end)
+	}
+	return filter
+}
+
+// NewCutoffFilter keeps services that have not ended before the cutoff
+func NewCutoffFilter(cutoff time.Time) *WindowFilter {
+	return NewWindowFilter(cutoff, time.Time{})
+}
+
+// midnight UTC of the date in the time's own location
+func date(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// first date of the window, service dates are parsed in the timezone of the feed
+func (w *WindowFilter) first(location *time.Location) time.Time {
+	if w.start.IsZero() {
+		// the host's date can already be tomorrow in the timezone of the feed
+		return date(time.Now().In(location))
 	}
+	return w.start
 }
 
-func (c *CutoffFilter) FilterService(service model.Service) bool {
-	valid := service.End.After(c.cutoff)
+func (w *WindowFilter) FilterService(service model.Service) bool {
+	_, added := w.addedServices[service.Id]
+	valid := added || (!date(service.End).Before(w.first(service.End.Location())) && (w.end.IsZero() || !date(service.Start).After(w.end)))
 	if valid {
-		c.validServices[service.Id] = struct{}{}
+		w.validServices[service.Id] = struct{}{}
 	}
 	return !valid
 }
 
-func (c *CutoffFilter) FilterServiceException(serviceException model.ServiceException) bool {
-	_, ok := c.validServices[serviceException.ServiceId]
+// exceptions are kept with their service, the added dates in the window keep the service
+func (w *WindowFilter) FilterServiceException(serviceException model.ServiceException) bool {
+	day := date(serviceException.Date)
+	if serviceException.Added && !day.Before(w.first(serviceException.Date.Location())) && (w.end.IsZero() || !day.After(w.end)) {
+		w.addedServices[serviceException.ServiceId] = struct{}{}
+	}
+	return false
+}
+
+func (w *WindowFilter) FilterTrip(trip model.Trip) bool {
+	_, ok := w.validServices[trip.ServiceId]
+	if ok {
+		w.validTrips[trip.Id] = struct{}{}
+		w.validShapes[trip.ShapeId] = struct{}{}
+	}
 	return !ok
 }
 
-func (c *CutoffFilter) FilterTrip(trip model.Trip) bool {
-	_, ok := c.validServices[trip.ServiceId]
+func (w *WindowFilter) FilterRoute(route model.Route) bool {
+	return false
+}
+
+func (w *WindowFilter) FilterStopTime(stoptime model.StopTime) bool {
+	_, ok := w.validTrips[stoptime.TripId]
+	return !ok
+}
+
+func (w *WindowFilter) FilterStop(stop model.Stop) bool {
+	return false
+}
+
+func (w *WindowFilter) FilterShape(shape model.Shape) bool {
+	_, ok := w.validShapes[shape.Id]
+	return !ok
+}
+
+/* RouteFilter
+keeps the routes in the allow-list and their trips, stop times and shapes. route IDs
+include the prefix of their feed. services and stops are kept
+*/
+type RouteFilter struct {
+	routes      map[string]struct{}
+	validTrips  map[string]struct{}
+	validShapes map[string]struct{}
+}
+
+func NewRouteFilter(routes []string) *RouteFilter {
+	filter := &RouteFilter{
+		routes:      map[string]struct{}{},
+		validTrips:  map[string]struct{}{},
+		validShapes: map[string]struct{}{},
+	}
+	for _, route := range routes {
+		filter.routes[route] = struct{}{}
+	}
+	return filter
+}
+
+func (r *RouteFilter) FilterService(service model.Service) bool {
+	return false
+}
+
+func (r *RouteFilter) FilterServiceException(serviceException model.ServiceException) bool {
+	return false
+}
+
+func (r *RouteFilter) FilterTrip(trip model.Trip) bool {
+	_, ok := r.routes[trip.RouteId]
 	if ok {
-		c.validTrips[trip.Id] = struct{}{}
-		c.validShapes[trip.ShapeId] = struct{}{}
-		c.validRoutes[trip.RouteId] = struct{}{}
+		r.validTrips[trip.Id] = struct{}{}
+		r.validShapes[trip.ShapeId] = struct{}{}
 	}
 	return !ok
 }
 
-func (c *CutoffFilter) FilterRoute(route model.Route) bool {
-	_, ok := c.validRoutes[route.Id]
+func (r *RouteFilter) FilterRoute(route model.Route) bool {
+	_, ok := r.routes[route.Id]
+	return !ok
+}
+
+func (r *RouteFilter) FilterStopTime(stoptime model.StopTime) bool {
+	_, ok := r.validTrips[stoptime.TripId]
+	return !ok
+}
+
+func (r *RouteFilter) FilterStop(stop model.Stop) bool {
+	return false
+}
+
+func (r *RouteFilter) FilterShape(shape model.Shape) bool {
+	_, ok := r.validShapes[shape.Id]
 	return !ok
 }
 
-func (c *CutoffFilter) FilterStopTime(stoptime model.StopTime) bool {
-	_, ok := c.validTrips[stoptime.TripId]
+/* AgencyFilter
+keeps the routes of the agencies in the allow-list and their trips, stop times and shapes.
+agencies are matched by agency_id including the prefix of their feed or by agency_name.
+services and stops are kept
+*/
+type AgencyFilter struct {
+	agencies    map[string]struct{}
+	validRoutes map[string]struct{}
+	validTrips  map[string]struct{}
+	validShapes map[string]struct{}
+}
+
+func NewAgencyFilter(agencies []string) *AgencyFilter {
+	filter := &AgencyFilter{
+		agencies:    map[string]struct{}{},
+		validRoutes: map[string]struct{}{},
+		validTrips:  map[string]struct{}{},
+		validShapes: map[string]struct{}{},
+	}
+	for _, agency := range agencies {
+		filter.agencies[agency] = struct{}{}
+	}
+	return filter
+}
+
+func (a *AgencyFilter) FilterService(service model.Service) bool {
+	return false
+}
+
+func (a *AgencyFilter) FilterServiceException(serviceException model.ServiceException) bool {
+	return false
+}
+
+func (a *AgencyFilter) FilterTrip(trip model.Trip) bool {
+	_, ok := a.validRoutes[trip.RouteId]
+	if ok {
+		a.validTrips[trip.Id] = struct{}{}
+		a.validShapes[trip.ShapeId] = struct{}{}
+	}
+	return !ok
+}
+
+func (a *AgencyFilter) FilterRoute(route model.Route) bool {
+	_, byId := a.agencies[route.AgencyId]
+	_, byName := a.agencies[route.Agency]
+	if byId || byName {
+		a.validRoutes[route.Id] = struct{}{}
+	}
+	return !byId && !byName
+}
+
+func (a *AgencyFilter) FilterStopTime(stoptime model.StopTime) bool {
+	_, ok := a.validTrips[stoptime.TripId]
 	return !ok
 }
 
-func (c *CutoffFilter) FilterStop(stop model.Stop) bool {
+func (a *AgencyFilter) FilterStop(stop model.Stop) bool {
 	return false
 }
 
-func (c *CutoffFilter) FilterShape(shape model.Shape) bool {
-	_, ok := c.validShapes[shape.Id]
+func (a *AgencyFilter) FilterShape(shape model.Shape) bool {
+	_, ok := a.validShapes[shape.Id]
 	return !ok
 }
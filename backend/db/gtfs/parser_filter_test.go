@@ -0,0 +1,105 @@
+package gtfs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+func TestWindowFilter(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2022, 9, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	filter := NewWindowFilter(day(10), day(20))
+
+	assert.True(t, filter.FilterService(model.Service{Id: "before", Start: day(1), End: day(9)}))
+	assert.True(t, filter.FilterService(model.Service{Id: "after", Start: day(21), End: day(30)}))
+	assert.False(t, filter.FilterService(model.Service{Id: "ends", Start: day(1), End: day(10)}))
+	assert.False(t, filter.FilterService(model.Service{Id: "starts", Start: day(20), End: day(30)}))
+
+	assert.False(t, filter.FilterTrip(model.Trip{Id: "T1", ServiceId: "ends", RouteId: "R1"}))
+	assert.True(t, filter.FilterTrip(model.Trip{Id: "T2", ServiceId: "after", RouteId: "R2"}))
+	assert.False(t, filter.FilterRoute(model.Route{Id: "R2"})) // routes without trips are pruned
+	assert.False(t, filter.FilterStopTime(model.StopTime{TripId: "T1"}))
+	assert.True(t, filter.FilterStopTime(model.StopTime{TripId: "T2"}))
+
+	// services outside the window are kept for their added dates inside it
+	assert.False(t, filter.FilterServiceException(model.ServiceException{ServiceId: "added", Date: day(15), Added: true}))
+	assert.False(t, filter.FilterServiceException(model.ServiceException{ServiceId: "removed", Date: day(15), Added: false}))
+	assert.False(t, filter.FilterServiceException(model.ServiceException{ServiceId: "outside", Date: day(25), Added: true}))
+	assert.False(t, filter.FilterService(model.Service{Id: "added", Start: day(1), End: day(5)}))
+	assert.True(t, filter.FilterService(model.Service{Id: "removed", Start: day(1), End: day(5)}))
+	assert.True(t, filter.FilterService(model.Service{Id: "outside", Start: day(1), End: day(5)}))
+
+	// services that end later in the day of the cutoff are kept
+	cutoff := NewCutoffFilter(time.Date(2022, 9, 10, 18, 0, 0, 0, time.UTC))
+	assert.False(t, cutoff.FilterService(model.Service{Id: "ends", Start: day(1), End: day(10)}))
+
+	// without a start, today is the date in the timezone of the services
+	kiritimati, _ := time.LoadLocation("Pacific/Kiritimati")
+	honolulu, _ := time.LoadLocation("Pacific/Honolulu")
+	today := func(location *time.Location) time.Time {
+		now := time.Now().In(location)
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	}
+
+	filter = NewWindowFilter(time.Time{}, time.Time{})
+	assert.False(t, filter.FilterService(model.Service{Id: "ahead", End: today(kiritimati)}))
+	assert.False(t, filter.FilterService(model.Service{Id: "behind", End: today(honolulu)}))
+	assert.True(t, filter.FilterService(model.Service{Id: "ended", End: today(kiritimati).AddDate(0, 0, -1)}))
+}
+
+func TestAgencyFilter(t *testing.T) {
+	filter := NewAgencyFilter([]string{"sto:STO", "OC Transpo"})
+
+	assert.False(t, filter.FilterRoute(model.Route{Id: "sto:33", AgencyId: "sto:STO", Agency: "Société de transport de l'Outaouais"}))
+	assert.False(t, filter.FilterRoute(model.Route{Id: "95", AgencyId: "1", Agency: "OC Transpo"}))
+	assert.True(t, filter.FilterRoute(model.Route{Id: "via:1", AgencyId: "via:VIA", Agency: "VIA Rail"}))
+
+	// trips, stop times and shapes follow their routes
+	assert.False(t, filter.FilterTrip(model.Trip{Id: "T1", RouteId: "sto:33", ShapeId: "SH1"}))
+	assert.True(t, filter.FilterTrip(model.Trip{Id: "T2", RouteId: "via:1", ShapeId: "SH2"}))
+	assert.False(t, filter.FilterStopTime(model.StopTime{TripId: "T1"}))
+	assert.True(t, filter.FilterStopTime(model.StopTime{TripId: "T2"}))
+	assert.False(t, filter.FilterShape(model.Shape{Id: "SH1"}))
+	assert.True(t, filter.FilterShape(model.Shape{Id: "SH2"}))
+	assert.False(t, filter.FilterService(model.Service{Id: "S"}))
+}
+
+func TestCombinedFilters(t *testing.T) {
+	services := func() ParserFilter {
+		filter := NewCutoffFilter(time.Date(2022, 9, 10, 0, 0, 0, 0, time.UTC))
+		filter.FilterService(model.Service{Id: "S", End: time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC)})
+		return filter
+	}
+
+	trips := []model.Trip{
+		{Id: "T1", ServiceId: "S", RouteId: "R1"},
+		{Id: "T2", ServiceId: "S", RouteId: "R2"},
+		{Id: "T3", ServiceId: "old", RouteId: "R1"},
+	}
+
+	kept := func(filter ParserFilter) []string {
+		ids := []string{}
+		for _, trip := range trips {
+			if !filter.FilterTrip(trip) {
+				ids = append(ids, trip.Id)
+			}
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{"T1"}, kept(And(services(), NewRouteFilter([]string{"R1"}))))
+	assert.Equal(t, []string{"T1", "T2", "T3"}, kept(Or(services(), NewRouteFilter([]string{"R1"}))))
+	assert.Equal(t, []string{"T1", "T2", "T3"}, kept(And()))
+
+	// stop times follow the trips each filter kept
+	filter := And(services(), NewRouteFilter([]string{"R1"}))
+	kept(filter)
+	assert.False(t, filter.FilterStopTime(model.StopTime{TripId: "T1"}))
+	assert.True(t, filter.FilterStopTime(model.StopTime{TripId: "T2"}))
+	assert.True(t, filter.FilterStopTime(model.StopTime{TripId: "T3"}))
+}
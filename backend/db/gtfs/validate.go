@@ -0,0 +1,392 @@
+package gtfs
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const dateLayout = "20060102"
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+type Issue struct {
+	Severity Severity `json:"severity"`
+	Code     string   `json:"code"`
+	File     string   `json:"file"`
+	Line     int      `json:"line,omitempty"` // line of the record, the header is line 1
+	Message  string   `json:"message"`
+}
+
+type Report struct {
+	Errors   int            `json:"errors"`
+	Warnings int            `json:"warnings"`
+	Counts   map[string]int `json:"counts"` // issues by code
+	Issues   []Issue        `json:"issues"`
+}
+
+func (r *Report) add(severity Severity, code, file string, line int, format string, args ...any) {
+	if severity == SeverityError {
+		r.Errors++
+	} else {
+		r.Warnings++
+	}
+
+	r.Counts[code]++
+	r.Issues = append(r.Issues, Issue{
+		Severity: severity,
+		Code:     code,
+		File:     file,
+		Line:     line,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+/* Validate
+reads the GTFS feed at the path (directory or .zip archive) and reports:
+- missing required files and files that cannot be read
+- cells that should be numbers but are not
+- references to trips, stops, routes, services and shapes that do not exist
+- unparsable times and dates
+- unknown agency timezones and agencies with different timezones
+- stop sequences that repeat or whose times go backwards
+- stops that are not used by any trip
+- frequencies with unknown trips, invalid times or headways
+- transfers and pathways with unknown stops, routes or trips
+the error is only set when the feed cannot be opened
+*/
+func Validate(path string) (*Report, error) {
+	input, missing, err := openInput(path)
+	if err != nil {
+		return nil, err
+	}
+	defer input.Close()
+
+	report := &Report{
+		Counts: map[string]int{},
+		Issues: []Issue{},
+	}
+
+	for _, name := range missing {
+		report.add(SeverityError, "missing_file", name, 0, "required file not found")
+	}
+
+	agencies, agencyLines := readValidate[Agency](report, "agency.txt", input.Agencies)
+	calendars, calendarLines := readValidate[Calendar](report, "calendar.txt", input.Calendars)
+	calendarDates, calendarDateLines := readValidate[CalendarDate](report, "calendar_dates.txt", input.CalendarDates)
+	routes, _ := readValidate[Route](report, "routes.txt", input.Routes)
+	stoptimes, stoptimeLines := readValidate[StopTime](report, "stop_times.txt", input.Stoptimes)
+	stops, stopLines := readValidate[Stop](report, "stops.txt", input.Stops)
+	trips, tripLines := readValidate[Trip](report, "trips.txt", input.Trips)
+	shapes, _ := readValidate[Shape](report, "shapes.txt", input.Shapes)
+	frequencies, frequencyLines := readValidate[Frequency](report, "frequencies.txt", input.Frequencies)
+	transfers, transferLines := readValidate[Transfer](report, "transfers.txt", input.Transfers)
+	pathways, pathwayLines := readValidate[Pathway](report, "pathways.txt", input.Pathways)
+
+	// ids referenced by other files
+	routeIds := ids(routes, func(r Route) string { return r.ID })
+	tripIds := ids(trips, func(t Trip) string { return t.ID })
+	stopIds := ids(stops, func(s Stop) string { return s.ID })
+	shapeIds := ids(shapes, func(s Shape) string { return s.ID })
+	serviceIds := ids(calendars, func(c Calendar) string { return c.ServiceID })
+	for id := range ids(calendarDates, func(c CalendarDate) string { return c.ServiceID }) {
+		serviceIds[id] = struct{}{}
+	}
+
+	validateAgencies(report, agencies, agencyLines)
+	validateCalendars(report, calendars, calendarLines)
+	validateCalendarDates(report, calendarDates, calendarDateLines)
+	validateTrips(report, trips, tripLines, routeIds, serviceIds, shapeIds)
+	validateStops(report, stops, stopLines, stopIds)
+	used := validateStopTimes(report, stoptimes, stoptimeLines, tripIds, stopIds)
+	validateUnusedStops(report, stops, stopLines, used)
+	validateFrequencies(report, frequencies, frequencyLines, tripIds)
+	validateTransfers(report, transfers, transferLines, stopIds, routeIds, tripIds)
+	validatePathways(report, pathways, pathwayLines, stopIds)
+
+	return report, nil
+}
+
+/* readValidate
+reads a file and the line of each record adding an issue when it cannot be read. records with
+cells that are not numbers are reported and kept with the invalid cells empty
+*/
+func readValidate[T any](report *Report, name string, input io.Reader) ([]T, []int) {
+	data, lines, err := read[T](name, input, func(line int, err error) bool {
+		report.add(SeverityError, "invalid_value", name, line, "%s", err)
+		return true
+	})
+	if err != nil {
+		report.add(SeverityError, "unreadable_file", name, 0, "%s", err)
+		return []T{}, []int{}
+	}
+	return data, lines
+}
+
+func ids[T any](records []T, key func(T) string) map[string]struct{} {
+	set := map[string]struct{}{}
+	for _, record := range records {
+		set[key(record)] = struct{}{}
+	}
+	return set
+}
+
+func validateAgencies(report *Report, agencies []Agency, lines []int) {
+	timezone := ""
+	for i, agency := range agencies {
+		name := strings.TrimSpace(agency.Timezone)
+		if _, err := time.LoadLocation(name); name == "" || err != nil {
+			report.add(SeverityError, "invalid_timezone", "agency.txt", lines[i], "invalid agency_timezone %q", agency.Timezone)
+			continue
+		}
+
+		if timezone == "" {
+			timezone = name
+		} else if name != timezone {
+			report.add(SeverityError, "invalid_timezone", "agency.txt", lines[i], "agency_timezone %q is different from %q", name, timezone)
+		}
+	}
+}
+
+func validateCalendars(report *Report, calendars []Calendar, lines []int) {
+	for i, calendar := range calendars {
+		start, startErr := time.Parse(dateLayout, calendar.Start)
+		if startErr != nil {
+			report.add(SeverityError, "invalid_date", "calendar.txt", lines[i], "invalid start_date %q", calendar.Start)
+		}
+
+		end, endErr := time.Parse(dateLayout, calendar.End)
+		if endErr != nil {
+			report.add(SeverityError, "invalid_date", "calendar.txt", lines[i], "invalid end_date %q", calendar.End)
+		}
+
+		if startErr == nil && endErr == nil && end.Before(start) {
+			report.add(SeverityError, "invalid_date", "calendar.txt", lines[i], "end_date %q is before start_date %q", calendar.End, calendar.Start)
+		}
+	}
+}
+
+func validateCalendarDates(report *Report, calendarDates []CalendarDate, lines []int) {
+	for i, calendarDate := range calendarDates {
+		if _, err := time.Parse(dateLayout, calendarDate.Date); err != nil {
+			report.add(SeverityError, "invalid_date", "calendar_dates.txt", lines[i], "invalid date %q", calendarDate.Date)
+		}
+
+		if calendarDate.ExceptionType != 1 && calendarDate.ExceptionType != 2 {
+			report.add(SeverityError, "invalid_value", "calendar_dates.txt", lines[i], "invalid exception_type %d", calendarDate.ExceptionType)
+		}
+	}
+}
+
+func validateTrips(report *Report, trips []Trip, lines []int, routeIds, serviceIds, shapeIds map[string]struct{}) {
+	for i, trip := range trips {
+		if _, ok := routeIds[trip.RouteID]; !ok {
+			report.add(SeverityError, "dangling_reference", "trips.txt", lines[i], "trip %q references unknown route_id %q", trip.ID, trip.RouteID)
+		}
+
+		if _, ok := serviceIds[trip.ServiceID]; !ok {
+			report.add(SeverityError, "dangling_reference", "trips.txt", lines[i], "trip %q references unknown service_id %q", trip.ID, trip.ServiceID)
+		}
+
+		if _, ok := shapeIds[trip.ShapeID]; trip.ShapeID != "" && !ok {
+			report.add(SeverityError, "dangling_reference", "trips.txt", lines[i], "trip %q references unknown shape_id %q", trip.ID, trip.ShapeID)
+		}
+	}
+}
+
+func validateStops(report *Report, stops []Stop, lines []int, stopIds map[string]struct{}) {
+	for i, stop := range stops {
+		if _, ok := stopIds[stop.Parent]; stop.Parent != "" && !ok {
+			report.add(SeverityError, "dangling_reference", "stops.txt", lines[i], "stop %q references unknown parent_station %q", stop.ID, stop.Parent)
+		}
+	}
+}
+
+type validateStopTime struct {
+	line      int
+	sequence  int
+	arrival   int // -1 when missing or invalid
+	departure int
+}
+
+// validate stop times returning the ids of the stops they use
+func validateStopTimes(report *Report, stoptimes []StopTime, lines []int, tripIds, stopIds map[string]struct{}) map[string]struct{} {
+	used := map[string]struct{}{}
+	byTrip := map[string][]validateStopTime{}
+
+	for i, stoptime := range stoptimes {
+		used[stoptime.StopID] = struct{}{}
+
+		if _, ok := tripIds[stoptime.TripID]; !ok {
+			report.add(SeverityError, "dangling_reference", "stop_times.txt", lines[i], "stop time references unknown trip_id %q", stoptime.TripID)
+		}
+
+		if _, ok := stopIds[stoptime.StopID]; !ok {
+			report.add(SeverityError, "dangling_reference", "stop_times.txt", lines[i], "stop time references unknown stop_id %q", stoptime.StopID)
+		}
+
+		if stoptime.Pickup < 0 || stoptime.Pickup > 3 {
+			report.add(SeverityError, "invalid_value", "stop_times.txt", lines[i], "invalid pickup_type %d", stoptime.Pickup)
+		}
+
+		if stoptime.DropOff < 0 || stoptime.DropOff > 3 {
+			report.add(SeverityError, "invalid_value", "stop_times.txt", lines[i], "invalid drop_off_type %d", stoptime.DropOff)
+		}
+
+		if timepoint := strings.TrimSpace(stoptime.Timepoint); timepoint != "" && timepoint != "0" && timepoint != "1" {
+			report.add(SeverityError, "invalid_value", "stop_times.txt", lines[i], "invalid timepoint %q", stoptime.Timepoint)
+		}
+
+		sequence, err := strconv.Atoi(strings.TrimSpace(stoptime.StopSeq))
+		if err != nil {
+			report.add(SeverityError, "invalid_value", "stop_times.txt", lines[i], "invalid stop_sequence %q", stoptime.StopSeq)
+			continue
+		}
+
+		byTrip[stoptime.TripID] = append(byTrip[stoptime.TripID], validateStopTime{
+			line:      lines[i],
+			sequence:  sequence,
+			arrival:   validateTime(report, "stop_times.txt", lines[i], "arrival_time", stoptime.Arrival),
+			departure: validateTime(report, "stop_times.txt", lines[i], "departure_time", stoptime.Departure),
+		})
+	}
+
+	tripIdsInOrder := make([]string, 0, len(byTrip))
+	for tripId := range byTrip {
+		tripIdsInOrder = append(tripIdsInOrder, tripId)
+	}
+	sort.Strings(tripIdsInOrder)
+
+	for _, tripId := range tripIdsInOrder {
+		trip := byTrip[tripId]
+		sort.SliceStable(trip, func(i, j int) bool {
+			return trip[i].sequence < trip[j].sequence
+		})
+
+		first, last := trip[0], trip[len(trip)-1]
+		if first.arrival < 0 && first.departure < 0 {
+			report.add(SeverityError, "invalid_time", "stop_times.txt", first.line, "first stop time of trip %q has no time", tripId)
+		}
+		if last.arrival < 0 && last.departure < 0 {
+			report.add(SeverityError, "invalid_time", "stop_times.txt", last.line, "last stop time of trip %q has no time", tripId)
+		}
+
+		previous := -1 // latest time on the trip so far
+		for i, stoptime := range trip {
+			if i > 0 && trip[i-1].sequence == stoptime.sequence {
+				report.add(SeverityError, "non_monotonic_stop_sequence", "stop_times.txt", stoptime.line, "trip %q repeats stop_sequence %d", tripId, stoptime.sequence)
+			}
+
+			for _, t := range []int{stoptime.arrival, stoptime.departure} {
+				if t < 0 {
+					continue
+				}
+				if t < previous {
+					report.add(SeverityError, "non_monotonic_stop_sequence", "stop_times.txt", stoptime.line, "trip %q goes back in time at stop_sequence %d", tripId, stoptime.sequence)
+					break
+				}
+				previous = t
+			}
+		}
+	}
+
+	return used
+}
+
+// validate an optional time returning -1 when it is missing or invalid
+func validateTime(report *Report, file string, line int, field, value string) int {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return -1
+	}
+
+	t, err := parseTime(value)
+	if err != nil {
+		report.add(SeverityError, "invalid_time", file, line, "invalid %s %q", field, value)
+		return -1
+	}
+
+	return int(t)
+}
+
+func validateUnusedStops(report *Report, stops []Stop, lines []int, used map[string]struct{}) {
+	for i, stop := range stops {
+		// stations, entrances and other location types are not used by stop times
+		if stop.Type != "" && stop.Type != "0" {
+			continue
+		}
+
+		if _, ok := used[stop.ID]; !ok {
+			report.add(SeverityWarning, "unused_stop", "stops.txt", lines[i], "stop %q is not used by any trip", stop.ID)
+		}
+	}
+}
+
+func validateFrequencies(report *Report, frequencies []Frequency, lines []int, tripIds map[string]struct{}) {
+	for i, frequency := range frequencies {
+		if _, ok := tripIds[frequency.TripID]; !ok {
+			report.add(SeverityError, "dangling_reference", "frequencies.txt", lines[i], "frequency references unknown trip_id %q", frequency.TripID)
+		}
+
+		start := validateTime(report, "frequencies.txt", lines[i], "start_time", frequency.Start)
+		end := validateTime(report, "frequencies.txt", lines[i], "end_time", frequency.End)
+		if start >= 0 && end >= 0 && end < start {
+			report.add(SeverityError, "invalid_time", "frequencies.txt", lines[i], "end_time %q is before start_time %q", frequency.End, frequency.Start)
+		}
+
+		if frequency.Headway <= 0 {
+			report.add(SeverityError, "invalid_value", "frequencies.txt", lines[i], "invalid headway_secs %d", frequency.Headway)
+		}
+
+		if frequency.ExactTimes != 0 && frequency.ExactTimes != 1 {
+			report.add(SeverityError, "invalid_value", "frequencies.txt", lines[i], "invalid exact_times %d", frequency.ExactTimes)
+		}
+	}
+}
+
+func validateTransfers(report *Report, transfers []Transfer, lines []int, stopIds, routeIds, tripIds map[string]struct{}) {
+	for i, transfer := range transfers {
+		references := []struct {
+			field string
+			value string
+			ids   map[string]struct{}
+		}{
+			{"from_stop_id", transfer.FromStopID, stopIds},
+			{"to_stop_id", transfer.ToStopID, stopIds},
+			{"from_route_id", transfer.FromRouteID, routeIds},
+			{"to_route_id", transfer.ToRouteID, routeIds},
+			{"from_trip_id", transfer.FromTripID, tripIds},
+			{"to_trip_id", transfer.ToTripID, tripIds},
+		}
+
+		for _, reference := range references {
+			if _, ok := reference.ids[reference.value]; reference.value != "" && !ok {
+				report.add(SeverityError, "dangling_reference", "transfers.txt", lines[i], "transfer references unknown %s %q", reference.field, reference.value)
+			}
+		}
+
+		if transfer.Type < 0 || transfer.Type > 5 {
+			report.add(SeverityError, "invalid_value", "transfers.txt", lines[i], "invalid transfer_type %d", transfer.Type)
+		}
+	}
+}
+
+func validatePathways(report *Report, pathways []Pathway, lines []int, stopIds map[string]struct{}) {
+	for i, pathway := range pathways {
+		if _, ok := stopIds[pathway.FromStopID]; !ok {
+			report.add(SeverityError, "dangling_reference", "pathways.txt", lines[i], "pathway %q references unknown from_stop_id %q", pathway.ID, pathway.FromStopID)
+		}
+
+		if _, ok := stopIds[pathway.ToStopID]; !ok {
+			report.add(SeverityError, "dangling_reference", "pathways.txt", lines[i], "pathway %q references unknown to_stop_id %q", pathway.ID, pathway.ToStopID)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package gtfs
+
+import (
+	"os"
+	fp "path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeFeed(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		assert.NoError(t, os.WriteFile(fp.Join(dir, name), []byte(content), 0644))
+	}
+	return dir
+}
+
+func TestValidate(t *testing.T) {
+	path := writeFeed(t, map[string]string{
+		"agency.txt": "agency_id,agency_name,agency_url,agency_timezone\n" +
+			"OC,OC Transpo,https://octranspo.com,America/Toronto\n" +
+			"STO,STO,https://sto.ca,Eastern\n",
+		"calendar.txt": "service_id,monday,tuesday,wednesday,thursday,friday,saturday,sunday,start_date,end_date\n" +
+			"WEEK,1,1,1,1,1,0,0,20230101,2023-12-31\n",
+		"routes.txt": "route_id,route_short_name,route_type\n" +
+			"1,1,3\n",
+		"trips.txt": "route_id,service_id,trip_id,shape_id\n" +
+			"1,WEEK,T1,\n" +
+			"2,WEEK,T2,S1\n",
+		"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\n" +
+			"A,\"A\nNorth\",45.0,-75.0\n" +
+			"B,B,45.1,-75.0\n" +
+			"\n" +
+			"C,C,45.2,-75.0\n",
+		"stop_times.txt": "trip_id,arrival_time,departure_time,stop_id,stop_sequence,pickup_type\n" +
+			"T1,08:00:00,08:00:00,A,1,0\n" +
+			"T1,07:00:00,07:00:00,B,2,x\n" +
+			"T2,8:00,08:00:00,X,1,\n",
+	})
+
+	report, err := Validate(path)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 0, report.Counts["missing_file"]) // calendar_dates.txt is optional with calendar.txt
+	assert.Equal(t, 1, report.Counts["invalid_date"])
+	assert.Equal(t, 1, report.Counts["invalid_timezone"])
+	assert.Equal(t, 1, report.Counts["invalid_time"])
+	assert.Equal(t, 3, report.Counts["dangling_reference"]) // route 2, shape S1, stop X
+	assert.Equal(t, 1, report.Counts["non_monotonic_stop_sequence"])
+	assert.Equal(t, 1, report.Counts["unused_stop"])
+	assert.Equal(t, 1, report.Warnings)
+	assert.Equal(t, 8, report.Errors)
+
+	// the record with a cell that is not a number is reported and the rest of the file is still checked
+	assert.Equal(t, 1, report.Counts["invalid_value"])
+	assert.Contains(t, report.Issues, Issue{
+		Severity: SeverityError,
+		Code:     "invalid_value",
+		File:     "stop_times.txt",
+		Line:     3,
+		Message:  `invalid pickup_type "x"`,
+	})
+
+	// lines count the line breaks inside quoted cells and the blank lines
+	assert.Contains(t, report.Issues, Issue{
+		Severity: SeverityWarning,
+		Code:     "unused_stop",
+		File:     "stops.txt",
+		Line:     6,
+		Message:  `stop "C" is not used by any trip`,
+	})
+}
@@ -0,0 +1,41 @@
+package model
+
+import "time"
+
+// Alert is a service disruption from a GTFS-Realtime ServiceAlerts feed
+type Alert struct {
+	Id          string
+	Header      string
+	Description string
+	URL         string
+	Cause       string // "CONSTRUCTION", "WEATHER"...
+	Effect      string // "DETOUR", "NO_SERVICE"...
+	Periods     []AlertPeriod // the alert is always active without periods
+}
+
+// AlertPeriod when an alert is active. a zero start or end is unbounded
+type AlertPeriod struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Active at the time
+func (a Alert) Active(t time.Time) bool {
+	return a.During(t, t)
+}
+
+// During the time from start to end. a zero end is unbounded
+func (a Alert) During(start, end time.Time) bool {
+	if len(a.Periods) == 0 {
+		return true
+	}
+
+	for _, period := range a.Periods {
+		startsBeforeEnd := end.IsZero() || period.Start.IsZero() || !period.Start.After(end)
+		endsAfterStart := period.End.IsZero() || !period.End.Before(start)
+		if startsBeforeEnd && endsAfterStart {
+			return true
+		}
+	}
+	return false
+}
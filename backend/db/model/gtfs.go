@@ -6,6 +6,7 @@ import (
 )
 
 type Dataset struct {
+	Agencies          []Agency
 	Routes            []Route
 	Stops             []Stop
 	StopTimes         []StopTime
@@ -13,6 +14,15 @@ type Dataset struct {
 	Services          []Service
 	ServiceExceptions []ServiceException
 	Shapes            []Shape
+	Transfers         []Transfer
+	Pathways          []Pathway
+}
+
+type Agency struct {
+	Id       string
+	Name     string
+	URL      string
+	Timezone string // IANA timezone "America/Toronto"
 }
 
 type Service struct {
@@ -22,6 +32,20 @@ type Service struct {
 	End   time.Time
 }
 
+// Merge appends the records of another dataset. IDs must not overlap
+func (d *Dataset) Merge(other *Dataset) {
+	d.Agencies = append(d.Agencies, other.Agencies...)
+	d.Routes = append(d.Routes, other.Routes...)
+	d.Stops = append(d.Stops, other.Stops...)
+	d.StopTimes = append(d.StopTimes, other.StopTimes...)
+	d.Trips = append(d.Trips, other.Trips...)
+	d.Services = append(d.Services, other.Services...)
+	d.ServiceExceptions = append(d.ServiceExceptions, other.ServiceExceptions...)
+	d.Shapes = append(d.Shapes, other.Shapes...)
+	d.Transfers = append(d.Transfers, other.Transfers...)
+	d.Pathways = append(d.Pathways, other.Pathways...)
+}
+
 func (s Service) ID() string {
 	return s.Id
 }
@@ -38,7 +62,13 @@ func (s ServiceException) ID() string {
 
 type Route struct {
 	Id              string
-	Name            string
+	AgencyId        string
+	Agency          string // agency_name
+	Name            string // short name "95"
+	LongName        string // "Barrhaven Centre / Orléans"
+	Description     string
+	URL             string
+	Type            int // route_type
 	BackgroundColor string
 	TextColor       string
 }
@@ -47,30 +77,111 @@ func (r Route) ID() string {
 	return r.Id
 }
 
+func (r Route) Mode() RouteMode {
+	return NewRouteMode(r.Type)
+}
+
+// RouteMode of transport, names match the GraphQL RouteMode enum
+type RouteMode string
+
+const (
+	RouteModeLRT        RouteMode = "LRT" // tram, streetcar, light rail
+	RouteModeSubway     RouteMode = "SUBWAY"
+	RouteModeRail       RouteMode = "RAIL"
+	RouteModeBus        RouteMode = "BUS"
+	RouteModeFerry      RouteMode = "FERRY"
+	RouteModeCableTram  RouteMode = "CABLE_TRAM"
+	RouteModeAerialLift RouteMode = "AERIAL_LIFT"
+	RouteModeFunicular  RouteMode = "FUNICULAR"
+	RouteModeTrolleybus RouteMode = "TROLLEYBUS"
+	RouteModeMonorail   RouteMode = "MONORAIL"
+)
+
+/* NewRouteMode
+mode of a GTFS route_type. extended route types are grouped into the basic mode
+they are closest to "https://developers.google.com/transit/gtfs/reference/extended-route-types".
+unknown route types are buses
+*/
+func NewRouteMode(routeType int) RouteMode {
+	switch {
+	case routeType == 12, routeType == 405:
+		return RouteModeMonorail
+	case routeType == 0, routeType >= 900 && routeType < 1000:
+		return RouteModeLRT
+	case routeType == 1, routeType >= 400 && routeType < 500:
+		return RouteModeSubway
+	case routeType == 2, routeType >= 100 && routeType < 200:
+		return RouteModeRail
+	case routeType == 4, routeType >= 1000 && routeType < 1300:
+		return RouteModeFerry
+	case routeType == 5:
+		return RouteModeCableTram
+	case routeType == 6, routeType >= 1300 && routeType < 1400:
+		return RouteModeAerialLift
+	case routeType == 7, routeType >= 1400 && routeType < 1500:
+		return RouteModeFunicular
+	case routeType == 11, routeType >= 800 && routeType < 900:
+		return RouteModeTrolleybus
+	default:
+		return RouteModeBus
+	}
+}
+
 type StopTime struct {
-	Time
-	TripId   string
-	StopId   string
-	Sequence int
-	Overflow bool // stop times can be past 24 hours.
+	Arrival     Time // arrival at the stop, used when alighting
+	Departure   Time // departure from the stop, used when boarding
+	TripId      string
+	StopId      string
+	Sequence    int
+	Headsign    string // stop_headsign, replaces the trip headsign at this stop
+	Pickup      StopType
+	DropOff     StopType
+	Approximate bool // timepoint is 0 or the time was interpolated
+}
+
+// riders can get on at the stop
+func (st StopTime) CanBoard() bool {
+	return st.Pickup != StopTypeNone
+}
+
+// riders can get off at the stop
+func (st StopTime) CanAlight() bool {
+	return st.DropOff != StopTypeNone
 }
 
+// StopType of pickup_type and drop_off_type
+type StopType int
+
+const (
+	StopTypeRegular              StopType = 0
+	StopTypeNone                 StopType = 1
+	StopTypePhoneAgency          StopType = 2
+	StopTypeCoordinateWithDriver StopType = 3
+)
+
 func (st StopTime) ID() string {
 	return fmt.Sprintf("stoptime:%s:%s:%d", st.StopId, st.TripId, st.Sequence)
 }
 
 type Stop struct {
 	Location
-	Id   string
-	Code string
-	Name string
-	Type string
+	Id         string
+	Agency     string
+	Code       string
+	Name       string
+	Type       string        // location_type
+	Parent     string        // id of the station, empty when the stop is not part of a station
+	Wheelchair Accessibility // wheelchair_boarding
 }
 
 func (s Stop) ID() string {
 	return s.Id
 }
 
+func (s Stop) IsStation() bool {
+	return s.Type == "1"
+}
+
 type Trip struct {
 	Id          string
 	RouteId     string
@@ -78,12 +189,39 @@ type Trip struct {
 	ShapeId     string
 	DirectionId string
 	Headsign    string
+	Name        string        // trip_short_name, the trip number shown to riders
+	Headway     time.Duration // frequency-based trips without exact times, departures are estimates
+	Wheelchair  Accessibility // wheelchair_accessible
+	Bikes       Accessibility // bikes_allowed
+	TemplateId  string        // trip in frequencies.txt the trip was expanded from, empty for other trips
 }
 
 func (t Trip) ID() string {
 	return t.Id
 }
 
+// GTFSId is the trip_id used by other GTFS files, trips expanded from frequencies.txt share the id of their template
+func (t Trip) GTFSId() string {
+	if t.TemplateId != "" {
+		return t.TemplateId
+	}
+	return t.Id
+}
+
+// TripFilter returns true for trips that can be used
+type TripFilter func(trip Trip) bool
+
+/* Accessibility
+wheelchair_boarding of stops, wheelchair_accessible and bikes_allowed of trips
+*/
+type Accessibility int
+
+const (
+	AccessibilityUnknown Accessibility = 0
+	Accessible           Accessibility = 1
+	NotAccessible        Accessibility = 2
+)
+
 type Shape struct {
 	Location
 	Id  string
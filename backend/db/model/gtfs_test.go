@@ -0,0 +1,50 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRouteMode(t *testing.T) {
+	for _, test := range []struct {
+		routeType int
+		mode      RouteMode
+	}{
+		// basic route types
+		{0, RouteModeLRT},
+		{1, RouteModeSubway},
+		{2, RouteModeRail},
+		{3, RouteModeBus},
+		{4, RouteModeFerry},
+		{5, RouteModeCableTram},
+		{6, RouteModeAerialLift},
+		{7, RouteModeFunicular},
+		{11, RouteModeTrolleybus},
+		{12, RouteModeMonorail},
+
+		// extended route types
+		{100, RouteModeRail},
+		{109, RouteModeRail}, // suburban railway
+		{200, RouteModeBus},  // coach
+		{400, RouteModeSubway},
+		{401, RouteModeSubway}, // metro
+		{405, RouteModeMonorail},
+		{700, RouteModeBus},
+		{715, RouteModeBus}, // demand and response bus
+		{800, RouteModeTrolleybus},
+		{900, RouteModeLRT},
+		{1000, RouteModeFerry},
+		{1200, RouteModeFerry},
+		{1300, RouteModeAerialLift},
+		{1400, RouteModeFunicular},
+		{1500, RouteModeBus}, // taxi
+		{1700, RouteModeBus}, // miscellaneous
+
+		// unknown route types
+		{8, RouteModeBus},
+		{-1, RouteModeBus},
+	} {
+		assert.Equal(t, test.mode, NewRouteMode(test.routeType), "route type %d", test.routeType)
+	}
+}
@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+/* Prediction
+realtime arrival and departure of a scheduled stop time. predicted is false when
+the delay at the stop is unknown
+*/
+type Prediction struct {
+	StopTime  StopTime
+	Date      time.Time // service day of the predicted trip
+	Arrival   time.Time
+	Departure time.Time
+	Delay     time.Duration // delay of the departure compared to the schedule, negative when early
+	Predicted bool
+	Skipped   bool // the trip does not stop here or is canceled
+}
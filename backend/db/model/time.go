@@ -6,46 +6,83 @@ import (
 	"time"
 )
 
+// sort stop times by the time of day they depart
 func StopTimeSort(stopTimes []StopTime) {
 	sort.Slice(stopTimes, func(i, j int) bool {
-		return stopTimes[i].Time < stopTimes[j].Time
+		return stopTimes[i].Departure.Clock() < stopTimes[j].Departure.Clock()
 	})
 }
 
+// sort stop times by the time of day they arrive
+func StopTimeSortByArrival(stopTimes []StopTime) {
+	sort.Slice(stopTimes, func(i, j int) bool {
+		return stopTimes[i].Arrival.Clock() < stopTimes[j].Arrival.Clock()
+	})
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+/* Time
+seconds since midnight of the service day. times can be past 24 hours, "25:30:00" is 1:30 AM on the
+day after the service day. times are clock times so they don't move when the clocks change
+*/
 type Time int
 
 func NewTimeFromDateTime(t time.Time) Time {
-	return NewTime(t.Hour(), t.Minute())
+	return NewTime(t.Hour(), t.Minute(), t.Second())
 }
 
-func NewTime(hours, minutes int) Time {
-	return Time(hours*60 + minutes)
+func NewTime(hours, minutes, seconds int) Time {
+	return Time(hours*60*60 + minutes*60 + seconds)
 }
 
+// hour of the day
 func (t Time) Hour() int {
-	return int(t / 60)
+	return int(t.Clock() / (60 * 60))
 }
 
 func (t Time) Minute() int {
+	return int(t % (60 * 60) / 60)
+}
+
+func (t Time) Second() int {
 	return int(t % 60)
 }
 
+// number of days after the service day
+func (t Time) Days() int {
+	return int(t / secondsPerDay)
+}
+
+// time of day
+func (t Time) Clock() Time {
+	return t % secondsPerDay
+}
+
 // t is after dt
 func (t Time) After(dt time.Time) bool {
-	return NewTimeFromDateTime(dt) <= t
+	return NewTimeFromDateTime(dt) <= t.Clock()
 }
 
 // t is before dt
 func (t Time) Before(dt time.Time) bool {
-	return NewTimeFromDateTime(dt) >= t
+	return NewTimeFromDateTime(dt) >= t.Clock()
 }
 
-func TimeDiff(t0, t1 Time) time.Duration {
-	if t1 < t0 {
-		t1 += 60 * 24
-	}
+// t on the same day as dt
+func (t Time) On(dt time.Time) time.Time {
+	year, month, day := dt.Date()
+	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), 0, dt.Location())
+}
+
+// t on a service day. times past 24 hours are on the following days
+func (t Time) OnServiceDay(date time.Time) time.Time {
+	return t.On(date).AddDate(0, 0, t.Days())
+}
 
-	return time.Duration(t1-t0) * time.Minute
+// duration from t0 to t1
+func TimeDiff(t0, t1 Time) time.Duration {
+	return time.Duration(t1-t0) * time.Second
 }
 
 func (t Time) String() string {
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeDays(t *testing.T) {
+	for _, test := range []struct {
+		time  Time
+		days  int
+		clock Time
+	}{
+		{NewTime(23, 59, 59), 0, NewTime(23, 59, 59)},
+		{NewTime(24, 0, 0), 1, NewTime(0, 0, 0)},
+		{NewTime(25, 30, 0), 1, NewTime(1, 30, 0)},
+		{NewTime(48, 5, 0), 2, NewTime(0, 5, 0)},
+	} {
+		assert.Equal(t, test.days, test.time.Days(), "days of %d", test.time)
+		assert.Equal(t, test.clock, test.time.Clock(), "clock of %d", test.time)
+	}
+
+	assert.Equal(t, 1, NewTime(25, 30, 0).Hour())
+	assert.Equal(t, 30, NewTime(25, 30, 0).Minute())
+}
+
+func TestTimeOnServiceDay(t *testing.T) {
+	toronto, _ := time.LoadLocation("America/Toronto")
+	day := func(month time.Month, day int) time.Time {
+		return time.Date(2022, month, day, 0, 0, 0, 0, toronto)
+	}
+
+	assert.Equal(t, time.Date(2022, 9, 13, 1, 30, 0, 0, toronto), NewTime(25, 30, 0).OnServiceDay(day(9, 12)))
+
+	// the clocks go forward at 2 AM on March 13th and back at 2 AM on November 6th
+	assert.Equal(t, time.Date(2022, 3, 13, 3, 0, 0, 0, toronto), NewTime(27, 0, 0).OnServiceDay(day(3, 12)))
+	assert.Equal(t, time.Date(2022, 3, 13, 8, 0, 0, 0, toronto), NewTime(8, 0, 0).OnServiceDay(day(3, 13)))
+	assert.Equal(t, time.Date(2022, 11, 6, 3, 0, 0, 0, toronto), NewTime(27, 0, 0).OnServiceDay(day(11, 5)))
+	assert.Equal(t, time.Date(2022, 11, 6, 8, 0, 0, 0, toronto), NewTime(8, 0, 0).OnServiceDay(day(11, 6)))
+
+	// the service days are 23 and 25 hours long
+	assert.Equal(t, 23*time.Hour, NewTime(24, 0, 0).OnServiceDay(day(3, 13)).Sub(day(3, 13)))
+	assert.Equal(t, 25*time.Hour, NewTime(24, 0, 0).OnServiceDay(day(11, 6)).Sub(day(11, 6)))
+}
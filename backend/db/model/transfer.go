@@ -0,0 +1,61 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// TransferType from transfers.txt
+type TransferType int
+
+const (
+	TransferRecommended     TransferType = 0
+	TransferTimed           TransferType = 1 // the departing vehicle waits for the arriving vehicle
+	TransferMinimumTime     TransferType = 2 // requires MinTime between arrival and departure
+	TransferForbidden       TransferType = 3
+	TransferInSeat          TransferType = 4 // stay on the vehicle from one trip to the next
+	TransferInSeatForbidden TransferType = 5 // must get off the vehicle between trips
+)
+
+// TransferPoint is one side of a transfer. empty fields match any stop, route or trip
+type TransferPoint struct {
+	StopId  string
+	RouteId string
+	TripId  string
+}
+
+type Transfer struct {
+	From    TransferPoint // alighting
+	To      TransferPoint // boarding
+	Type    TransferType
+	MinTime time.Duration
+}
+
+// Pathway between two locations in a station from pathways.txt
+type Pathway struct {
+	Id            string
+	FromStopId    string
+	ToStopId      string
+	Mode          int // walkway, stairs, escalator, elevator, etc
+	Bidirectional bool
+	Length        float64       // meters, 0 when unknown
+	TraversalTime time.Duration // 0 when unknown
+}
+
+// pathway_mode
+const (
+	PathwayModeStairs    = 2
+	PathwayModeEscalator = 4
+)
+
+// ErrInaccessiblePathway is returned when locations are only connected by stairs or escalators
+var ErrInaccessiblePathway = errors.New("no wheelchair accessible pathway")
+
+// wheelchairs can't use stairs or escalators
+func (p Pathway) Accessible() bool {
+	return p.Mode != PathwayModeStairs && p.Mode != PathwayModeEscalator
+}
+
+func (p Pathway) ID() string {
+	return p.Id
+}
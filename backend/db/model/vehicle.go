@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// Vehicle position from a GTFS-Realtime VehiclePositions feed
+type Vehicle struct {
+	Id          string
+	Label       string // label shown to riders, the bus number
+	TripId      string // empty when the vehicle is not on a trip
+	RouteId     string // empty when the feed omits it
+	Location    Location
+	Bearing     *float64 // degrees clockwise from north
+	Speed       *float64 // meters per second
+	LastUpdated time.Time
+}
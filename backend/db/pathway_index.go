@@ -0,0 +1,130 @@
+package db
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"stop-checker.com/db/model"
+	"stop-checker.com/db/repository"
+)
+
+// walking speed used for pathways with a length but no traversal time
+const pathwayWalkSpeed = 1.3 // meters per second
+
+// time to use a pathway without a length or traversal time
+const pathwayDefaultTime = 30 * time.Second
+
+type pathwayEdge struct {
+	to         string
+	duration   time.Duration
+	accessible bool
+}
+
+/* PathwayIndex
+shortest walking times between locations inside stations using pathways.txt.
+boarding areas are treated as part of their platform
+*/
+type PathwayIndex struct {
+	stopsByParent repository.InvertedIndex[model.Stop]
+	graph         map[string][]pathwayEdge
+}
+
+func NewPathwayIndex(pathways []model.Pathway, stopsByParent repository.InvertedIndex[model.Stop]) *PathwayIndex {
+	graph := map[string][]pathwayEdge{}
+
+	for _, pathway := range pathways {
+		duration, accessible := pathwayDuration(pathway), pathway.Accessible()
+
+		graph[pathway.FromStopId] = append(graph[pathway.FromStopId], pathwayEdge{to: pathway.ToStopId, duration: duration, accessible: accessible})
+		if pathway.Bidirectional {
+			graph[pathway.ToStopId] = append(graph[pathway.ToStopId], pathwayEdge{to: pathway.FromStopId, duration: duration, accessible: accessible})
+		}
+	}
+
+	return &PathwayIndex{
+		stopsByParent: stopsByParent,
+		graph:         graph,
+	}
+}
+
+func pathwayDuration(pathway model.Pathway) time.Duration {
+	if pathway.TraversalTime > 0 {
+		return pathway.TraversalTime
+	}
+
+	if pathway.Length > 0 {
+		return time.Duration(math.Round(pathway.Length/pathwayWalkSpeed)) * time.Second
+	}
+
+	return pathwayDefaultTime
+}
+
+// locations of a stop in the pathway graph, the stop and its boarding areas
+func (p *PathwayIndex) locations(stopId string) []string {
+	locations := []string{stopId}
+	children, _ := p.stopsByParent.Get(stopId)
+	for _, child := range children {
+		locations = append(locations, child.Id)
+	}
+	return locations
+}
+
+/* Duration
+of the quickest pathways from one stop to another. accessible durations don't use stairs or escalators,
+model.ErrInaccessiblePathway is returned when the stops are only connected by them
+*/
+func (p *PathwayIndex) Duration(fromStopId, toStopId string, accessible bool) (time.Duration, error) {
+	duration, err := p.duration(fromStopId, toStopId, accessible)
+	if err != nil && accessible {
+		if _, inaccessible := p.duration(fromStopId, toStopId, false); inaccessible == nil {
+			return 0, model.ErrInaccessiblePathway
+		}
+	}
+	return duration, err
+}
+
+func (p *PathwayIndex) duration(fromStopId, toStopId string, accessible bool) (time.Duration, error) {
+	targets := map[string]struct{}{}
+	for _, location := range p.locations(toStopId) {
+		targets[location] = struct{}{}
+	}
+
+	// dijkstra, station graphs are small so the closest location is found with a scan
+	durations := map[string]time.Duration{}
+	for _, location := range p.locations(fromStopId) {
+		durations[location] = 0
+	}
+	visited := map[string]struct{}{}
+
+	for {
+		current, found := "", false
+		for location, duration := range durations {
+			if _, ok := visited[location]; ok {
+				continue
+			}
+			if !found || duration < durations[current] {
+				current, found = location, true
+			}
+		}
+
+		if !found {
+			return 0, errors.New("no pathway")
+		}
+
+		if _, ok := targets[current]; ok {
+			return durations[current], nil
+		}
+		visited[current] = struct{}{}
+
+		for _, edge := range p.graph[current] {
+			if accessible && !edge.accessible {
+				continue
+			}
+			duration := durations[current] + edge.duration
+			if previous, ok := durations[edge.to]; !ok || duration < previous {
+				durations[edge.to] = duration
+			}
+		}
+	}
+}
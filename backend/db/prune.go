@@ -0,0 +1,128 @@
+package db
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"stop-checker.com/db/model"
+)
+
+/* prune
+removes the records that no remaining trip uses after the feeds were filtered. agencies are kept
+when a remaining route belongs to them, the first agency is always kept for the timezone. stops are kept when a stop time visits them, when they are
+the station of a kept stop, or when they are the entrance, node or boarding area of a kept station
+or platform. transfers and pathways between removed stops are removed with them
+*/
+func prune(dataset *model.Dataset) {
+	t0 := time.Now()
+
+	routes := map[string]struct{}{}
+	services := map[string]struct{}{}
+	shapes := map[string]struct{}{}
+	for _, trip := range dataset.Trips {
+		routes[trip.RouteId] = struct{}{}
+		services[trip.ServiceId] = struct{}{}
+		shapes[trip.ShapeId] = struct{}{}
+	}
+
+	stops := map[string]struct{}{}
+	for _, stopTime := range dataset.StopTimes {
+		stops[stopTime.StopId] = struct{}{}
+	}
+
+	// keep the stations of the visited stops, then the entrances, nodes and boarding areas inside them
+	parents := map[string]string{}
+	for _, stop := range dataset.Stops {
+		parents[stop.Id] = stop.Parent
+	}
+	for stopId := range stops {
+		for parent := parents[stopId]; parent != ""; parent = parents[parent] {
+			stops[parent] = struct{}{}
+		}
+	}
+	for _, stop := range dataset.Stops {
+		if stop.Parent == "" || stop.Type == "" || stop.Type == "0" || stop.Type == "1" {
+			continue
+		}
+		if _, ok := stops[stop.Parent]; ok {
+			stops[stop.Id] = struct{}{}
+		}
+	}
+
+	hasStop := func(id string) bool {
+		_, ok := stops[id]
+		return ok
+	}
+
+	before := *dataset
+
+	dataset.Routes = keep(dataset.Routes, func(route model.Route) bool {
+		_, ok := routes[route.Id]
+		return ok
+	})
+
+	// the timezone of the dataset comes from the first agency so it is kept even without routes
+	agencies := map[string]struct{}{}
+	if len(dataset.Agencies) > 0 {
+		agencies[dataset.Agencies[0].Id] = struct{}{}
+	}
+	for _, route := range dataset.Routes {
+		agencies[route.AgencyId] = struct{}{}
+	}
+	dataset.Agencies = keep(dataset.Agencies, func(agency model.Agency) bool {
+		_, ok := agencies[agency.Id]
+		return ok
+	})
+
+	dataset.Stops = keep(dataset.Stops, func(stop model.Stop) bool {
+		return hasStop(stop.Id)
+	})
+
+	dataset.Shapes = keep(dataset.Shapes, func(shape model.Shape) bool {
+		_, ok := shapes[shape.Id]
+		return ok
+	})
+
+	dataset.Services = keep(dataset.Services, func(service model.Service) bool {
+		_, ok := services[service.Id]
+		return ok
+	})
+
+	dataset.ServiceExceptions = keep(dataset.ServiceExceptions, func(serviceException model.ServiceException) bool {
+		_, ok := services[serviceException.ServiceId]
+		return ok
+	})
+
+	// transfers without a stop are between routes or trips
+	dataset.Transfers = keep(dataset.Transfers, func(transfer model.Transfer) bool {
+		return (transfer.From.StopId == "" || hasStop(transfer.From.StopId)) &&
+			(transfer.To.StopId == "" || hasStop(transfer.To.StopId))
+	})
+
+	dataset.Pathways = keep(dataset.Pathways, func(pathway model.Pathway) bool {
+		return hasStop(pathway.FromStopId) && hasStop(pathway.ToStopId)
+	})
+
+	log.Info().
+		Dur("duration", time.Since(t0)).
+		Int("agencies", len(before.Agencies)-len(dataset.Agencies)).
+		Int("routes", len(before.Routes)-len(dataset.Routes)).
+		Int("stops", len(before.Stops)-len(dataset.Stops)).
+		Int("shapes", len(before.Shapes)-len(dataset.Shapes)).
+		Int("services", len(before.Services)-len(dataset.Services)).
+		Int("service-exceptions", len(before.ServiceExceptions)-len(dataset.ServiceExceptions)).
+		Int("transfers", len(before.Transfers)-len(dataset.Transfers)).
+		Int("pathways", len(before.Pathways)-len(dataset.Pathways)).
+		Msg("pruned records without trips")
+}
+
+// keep the records in place. the records removed are overwritten
+func keep[T any](records []T, kept func(record T) bool) []T {
+	results := records[:0]
+	for _, record := range records {
+		if kept(record) {
+			results = append(results, record)
+		}
+	}
+	return results
+}
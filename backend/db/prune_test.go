@@ -0,0 +1,70 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+func TestPrune(t *testing.T) {
+	dataset := &model.Dataset{
+		Agencies: []model.Agency{{Id: "A1"}, {Id: "A2"}},
+		Routes:   []model.Route{{Id: "R1", AgencyId: "A1"}, {Id: "R2", AgencyId: "A2"}},
+		Trips:    []model.Trip{{Id: "T1", RouteId: "R1", ServiceId: "S1", ShapeId: "SH1"}},
+		StopTimes: []model.StopTime{
+			{TripId: "T1", StopId: "P1"},
+			{TripId: "T1", StopId: "B"},
+		},
+		Stops: []model.Stop{
+			{Id: "STATION", Type: "1"},
+			{Id: "P1", Parent: "STATION"},
+			{Id: "P2", Parent: "STATION"}, // platform without trips
+			{Id: "ENTRANCE", Type: "2", Parent: "STATION"},
+			{Id: "B"},
+			{Id: "C"},
+		},
+		Shapes:            []model.Shape{{Id: "SH1"}, {Id: "SH2"}},
+		Services:          []model.Service{{Id: "S1"}, {Id: "S2"}},
+		ServiceExceptions: []model.ServiceException{{ServiceId: "S1"}, {ServiceId: "S2"}},
+		Transfers: []model.Transfer{
+			{From: model.TransferPoint{StopId: "P1"}, To: model.TransferPoint{StopId: "B"}},
+			{From: model.TransferPoint{StopId: "B"}, To: model.TransferPoint{StopId: "C"}},
+			{From: model.TransferPoint{RouteId: "R1"}, To: model.TransferPoint{RouteId: "R2"}},
+		},
+		Pathways: []model.Pathway{
+			{Id: "W1", FromStopId: "ENTRANCE", ToStopId: "P1"},
+			{Id: "W2", FromStopId: "ENTRANCE", ToStopId: "P2"},
+		},
+	}
+
+	prune(dataset)
+
+	stops := []string{}
+	for _, stop := range dataset.Stops {
+		stops = append(stops, stop.Id)
+	}
+
+	assert.Equal(t, []model.Agency{{Id: "A1"}}, dataset.Agencies)
+	assert.Equal(t, []model.Route{{Id: "R1", AgencyId: "A1"}}, dataset.Routes)
+	assert.Equal(t, []string{"STATION", "P1", "ENTRANCE", "B"}, stops)
+	assert.Equal(t, []model.Shape{{Id: "SH1"}}, dataset.Shapes)
+	assert.Equal(t, []model.Service{{Id: "S1"}}, dataset.Services)
+	assert.Equal(t, []model.ServiceException{{ServiceId: "S1"}}, dataset.ServiceExceptions)
+	assert.Len(t, dataset.Transfers, 2)
+	assert.Len(t, dataset.Pathways, 1)
+	assert.Equal(t, "W1", dataset.Pathways[0].Id)
+}
+
+func TestPruneKeepsTimezone(t *testing.T) {
+	dataset := &model.Dataset{
+		Agencies: []model.Agency{{Id: "A1", Timezone: "America/Toronto"}, {Id: "A2", Timezone: "America/Vancouver"}},
+		Routes:   []model.Route{{Id: "R1", AgencyId: "A1"}, {Id: "R2", AgencyId: "A2"}},
+	}
+
+	// every trip was filtered out
+	prune(dataset)
+
+	assert.Equal(t, []model.Agency{{Id: "A1", Timezone: "America/Toronto"}}, dataset.Agencies)
+	assert.Equal(t, "America/Toronto", agencyLocation(dataset.Agencies).String())
+}
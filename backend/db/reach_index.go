@@ -15,6 +15,9 @@ type hashStopInfo struct {
 
 	// used to sort reachable stops compared with other hashes
 	sequence int
+
+	// the trips of the hash pick up or drop off at the stop
+	board, alight bool
 }
 
 type reachableResults map[string]map[string]hashStopInfo // { reachable stop id: { trip hash: hash stop info }}
@@ -64,20 +67,26 @@ func NewReachIndex(
 		if !seen {
 			tripsByHash[hash] = map[string]struct{}{}
 			stopsByHash[hash] = map[string]hashStopInfo{}
+		}
 
-			// add the hash to stops
-			for i, stoptime := range stopTimes {
-				srId := stopRouteId(stoptime.StopId, trip.RouteId)
-				if _, ok := hashesByStopRoute[srId]; !ok {
-					hashesByStopRoute[srId] = map[string]hashStopInfo{}
-				}
-				info := hashStopInfo{
-					index:    i,
-					sequence: stoptime.Sequence,
-				}
-				hashesByStopRoute[srId][hash] = info
-				stopsByHash[hash][stoptime.StopId] = info
+		// add the hash to stops
+		for i, stoptime := range stopTimes {
+			srId := stopRouteId(stoptime.StopId, trip.RouteId)
+			if _, ok := hashesByStopRoute[srId]; !ok {
+				hashesByStopRoute[srId] = map[string]hashStopInfo{}
 			}
+
+			info := hashesByStopRoute[srId][hash]
+			if !seen {
+				// trips of the same hash have the same pickup and drop off types
+				info.index = i
+				info.sequence = stoptime.Sequence
+				info.board = stoptime.CanBoard()
+				info.alight = stoptime.CanAlight()
+			}
+
+			hashesByStopRoute[srId][hash] = info
+			stopsByHash[hash][stoptime.StopId] = info
 		}
 
 		tripsByHash[hash][trip.ID()] = struct{}{}
@@ -141,8 +150,9 @@ returns the *ScheduleResults for the all stop times that run between the origin
 (origin, destination)
 - used by the travel.Scheduler
 - used to provide alternative stop times for travel plans
+only trips kept by the filter are scheduled, every trip when the filter is nil
 */
-func (r *ReachIndex) ReachableBetweenWithSchedule(originId, destinationId, routeId string) (repository.Schedule, repository.Schedule) {
+func (r *ReachIndex) ReachableBetweenWithSchedule(originId, destinationId, routeId string, filter model.TripFilter) (repository.Schedule, repository.Schedule) {
 	// hashes that visit the origin and destination
 	originHashes := r.hashesByStopRoute[stopRouteId(originId, routeId)]
 	destinationHashes := r.hashesByStopRoute[stopRouteId(destinationId, routeId)]
@@ -157,32 +167,51 @@ func (r *ReachIndex) ReachableBetweenWithSchedule(originId, destinationId, route
 			continue
 		}
 
-		// add stop times from each trip
+		// add stop times from each trip that picks up at the origin and drops off at the destination
 		for tripId := range r.tripsByHash[hash] {
 			stopTimes, _ := r.stopTimesByTrip.Get(tripId)
-			originStopTimes = append(originStopTimes, stopTimes[originInfo.index])
-			destinationStopTimes = append(destinationStopTimes, stopTimes[destinationInfo.index])
+			origin, destination := stopTimes[originInfo.index], stopTimes[destinationInfo.index]
+			if !origin.CanBoard() || !destination.CanAlight() {
+				continue
+			}
+			originStopTimes = append(originStopTimes, origin)
+			destinationStopTimes = append(destinationStopTimes, destination)
 		}
 	}
 
-	// sort stop times and create schedule results
+	// riders board at the origin and alight at the destination so the destination is sorted by arrival
 	model.StopTimeSort(originStopTimes)
-	model.StopTimeSort(destinationStopTimes)
+	model.StopTimeSortByArrival(destinationStopTimes)
 
 	originResults := &ScheduleResults{
 		indexesRequiredBySchedule: r.indexesRequiredBySchedule,
 		results:                   originStopTimes,
+		filter:                    filter,
 	}
 
 	destinationResults := &ScheduleResults{
 		indexesRequiredBySchedule: r.indexesRequiredBySchedule,
 		results:                   destinationStopTimes,
+		filter:                    filter,
 	}
 
 	return originResults, destinationResults
 }
 
-func (r *ReachIndex) ReachableForwardWithNext(originId, routeId string, after time.Time) []model.ReachableSchedule {
+func (r *ReachIndex) ReachableForwardWithNext(originId, routeId string, after time.Time, filter model.TripFilter) []model.ReachableSchedule {
+	return r.reachableForward(originId, routeId, func(results *ScheduleResults) (model.ScheduleResult, error) {
+		return results.Filter(filter).Next(after)
+	})
+}
+
+// ReachableForwardWithin is ReachableForwardWithNext only using trips departing the origin before a time
+func (r *ReachIndex) ReachableForwardWithin(originId, routeId string, after, before time.Time, filter model.TripFilter) []model.ReachableSchedule {
+	return r.reachableForward(originId, routeId, func(results *ScheduleResults) (model.ScheduleResult, error) {
+		return results.Filter(filter).NextBefore(after, before)
+	})
+}
+
+func (r *ReachIndex) reachableForward(originId, routeId string, query func(results *ScheduleResults) (model.ScheduleResult, error)) []model.ReachableSchedule {
 	/*
 		1. get all stop times (as a *ScheduleResults object) for each hash
 		2. get next stop time for each *ScheduleResults for each hash
@@ -194,7 +223,7 @@ func (r *ReachIndex) ReachableForwardWithNext(originId, routeId string, after ti
 	originNextByHash := map[string]model.ScheduleResult{}
 
 	for hash, originScheduleResults := range originScheduleResultsByHash {
-		next, err := originScheduleResults.Next(after)
+		next, err := query(originScheduleResults)
 		if err != nil {
 			continue
 		}
@@ -229,7 +258,10 @@ func (r *ReachIndex) ReachableForwardWithNext(originId, routeId string, after ti
 				// find the arrival time
 				stopTimes, _ := r.stopTimesByTrip.Get(departureStopTime.TripId)
 				arrivalStopTime := stopTimes[destinationInfo.index]
-				arrival := departure.Add(model.TimeDiff(departureStopTime.Time, arrivalStopTime.Time))
+				if !arrivalStopTime.CanAlight() {
+					continue
+				}
+				arrival := departure.Add(model.TimeDiff(departureStopTime.Departure, arrivalStopTime.Arrival))
 
 				// update result fields
 				result.Departure = departure
@@ -250,13 +282,26 @@ func (r *ReachIndex) ReachableForwardWithNext(originId, routeId string, after ti
 	return results
 }
 
-func (r *ReachIndex) ReachableBackwardWithPrevious(destinationId, routeId string, before time.Time) []model.ReachableSchedule {
+func (r *ReachIndex) ReachableBackwardWithPrevious(destinationId, routeId string, before time.Time, filter model.TripFilter) []model.ReachableSchedule {
+	return r.reachableBackward(destinationId, routeId, func(results *ScheduleResults) (model.ScheduleResult, error) {
+		return results.Filter(filter).Previous(before)
+	})
+}
+
+// ReachableBackwardWithin is ReachableBackwardWithPrevious only using trips arriving at the destination after a time
+func (r *ReachIndex) ReachableBackwardWithin(destinationId, routeId string, before, after time.Time, filter model.TripFilter) []model.ReachableSchedule {
+	return r.reachableBackward(destinationId, routeId, func(results *ScheduleResults) (model.ScheduleResult, error) {
+		return results.Filter(filter).PreviousAfter(before, after)
+	})
+}
+
+func (r *ReachIndex) reachableBackward(destinationId, routeId string, query func(results *ScheduleResults) (model.ScheduleResult, error)) []model.ReachableSchedule {
 	destination, _ := r.stops.Get(destinationId)
 	destinationScheduleResultsByHash := r.stopTimesByHash(destinationId, routeId)
 	destinationPreviousByHash := map[string]model.ScheduleResult{}
 
 	for hash, destinationScheduleResults := range destinationScheduleResultsByHash {
-		next, err := destinationScheduleResults.Previous(before)
+		next, err := query(destinationScheduleResults)
 		if err != nil {
 			continue
 		}
@@ -291,7 +336,10 @@ func (r *ReachIndex) ReachableBackwardWithPrevious(destinationId, routeId string
 				// find the arrival time
 				stopTimes, _ := r.stopTimesByTrip.Get(arrivalStopTime.TripId)
 				departureStopTime := stopTimes[originInfo.index]
-				departure := arrival.Add(-model.TimeDiff(departureStopTime.Time, arrivalStopTime.Time))
+				if !departureStopTime.CanBoard() {
+					continue
+				}
+				departure := arrival.Add(-model.TimeDiff(departureStopTime.Departure, arrivalStopTime.Arrival))
 
 				// update result fields
 				result.Departure = departure
@@ -313,7 +361,9 @@ func (r *ReachIndex) ReachableBackwardWithPrevious(destinationId, routeId string
 }
 
 /* reachable
-returns what trip hashes can be used to reach each stop
+returns what trip hashes can be used to reach each stop.
+trips are boarded at the origin and left at the destination (the reverse when reverse is true)
+so hashes that never pick up or drop off at those stops are ignored
 */
 func (r *ReachIndex) reachable(originId string, routeId string, reverse bool) reachableResults {
 	originHashes := r.hashesByStopRoute[stopRouteId(originId, routeId)]
@@ -321,11 +371,19 @@ func (r *ReachIndex) reachable(originId string, routeId string, reverse bool) re
 	reachable := map[string]map[string]hashStopInfo{}
 
 	for originHash, originInfo := range originHashes {
+		if (!reverse && !originInfo.board) || (reverse && !originInfo.alight) {
+			continue
+		}
+
 		for destination, destinationInfo := range r.stopsByHash[originHash] {
 			if destination == originId {
 				continue
 			}
 
+			if (!reverse && !destinationInfo.alight) || (reverse && !destinationInfo.board) {
+				continue
+			}
+
 			if !reverse && originInfo.sequence >= destinationInfo.sequence {
 				continue
 			}
@@ -363,9 +421,7 @@ func (r *ReachIndex) stopTimesByHashFunc(stopId, routeId string) map[string]*Sch
 			stopTimes = append(stopTimes, stoptime)
 		}
 
-		sort.Slice(stopTimes, func(i, j int) bool {
-			return stopTimes[i].Time < stopTimes[j].Time
-		})
+		model.StopTimeSort(stopTimes)
 
 		stopTimesByHash[hash] = &ScheduleResults{
 			indexesRequiredBySchedule: r.indexesRequiredBySchedule,
@@ -380,10 +436,26 @@ func stopRouteId(stopId, routeId string) string {
 	return fmt.Sprintf("%s:%s", stopId, routeId)
 }
 
+/* hashTrip
+trips with the same hash share the stop time indexes and the stops they pick up or drop off at,
+so the next trip of a hash can always be used from the stops of the hash
+*/
 func hashTrip(trip model.Trip, stoptimes []model.StopTime) (string, error) {
 	if len(stoptimes) == 0 {
 		// trip has zero stop times.
 		return "", nil
 	}
-	return fmt.Sprintf("%s:%s:%s:%s:%d", trip.RouteId, trip.DirectionId, stoptimes[0].StopId, stoptimes[len(stoptimes)-1].StopId, len(stoptimes)), nil
+
+	// index of the stops without a pickup (p) or drop off (d)
+	restricted := ""
+	for i, stoptime := range stoptimes {
+		if !stoptime.CanBoard() {
+			restricted += fmt.Sprintf(":%dp", i)
+		}
+		if !stoptime.CanAlight() {
+			restricted += fmt.Sprintf(":%dd", i)
+		}
+	}
+
+	return fmt.Sprintf("%s:%s:%s:%s:%d%s", trip.RouteId, trip.DirectionId, stoptimes[0].StopId, stoptimes[len(stoptimes)-1].StopId, len(stoptimes), restricted), nil
 }
@@ -0,0 +1,164 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+func TestReachIndexPickupDropOff(t *testing.T) {
+	// B is drop off only, C is pickup only
+	database := NewDB(&model.Dataset{
+		Routes: []model.Route{{Id: "R"}},
+		Services: []model.Service{{
+			Id:    "S",
+			On:    [7]bool{true, true, true, true, true, true, true},
+			Start: time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local),
+			End:   time.Date(2022, 12, 31, 0, 0, 0, 0, time.Local),
+		}},
+		Stops: []model.Stop{{Id: "A"}, {Id: "B"}, {Id: "C"}, {Id: "D"}},
+		Trips: []model.Trip{{Id: "T", RouteId: "R", ServiceId: "S"}},
+		StopTimes: []model.StopTime{
+			{TripId: "T", StopId: "A", Sequence: 1, Arrival: model.NewTime(8, 0, 0), Departure: model.NewTime(8, 0, 0)},
+			{TripId: "T", StopId: "B", Sequence: 2, Arrival: model.NewTime(8, 5, 0), Departure: model.NewTime(8, 5, 0), Pickup: model.StopTypeNone},
+			{TripId: "T", StopId: "C", Sequence: 3, Arrival: model.NewTime(8, 10, 0), Departure: model.NewTime(8, 10, 0), DropOff: model.StopTypeNone},
+			{TripId: "T", StopId: "D", Sequence: 4, Arrival: model.NewTime(8, 15, 0), Departure: model.NewTime(8, 15, 0)},
+		},
+	})
+
+	ids := func(stops []model.Stop) []string {
+		results := []string{}
+		for _, stop := range stops {
+			results = append(results, stop.Id)
+		}
+		return results
+	}
+
+	assert.Equal(t, []string{"B", "D"}, ids(database.ReachIndex.Reachable("A", "R", false)))
+	assert.Equal(t, []string{"A", "C"}, ids(database.ReachIndex.Reachable("D", "R", true)))
+	assert.Empty(t, database.ReachIndex.Reachable("B", "R", false))
+	assert.Empty(t, database.ReachIndex.Reachable("C", "R", true))
+
+	at := time.Date(2022, 9, 1, 7, 0, 0, 0, time.Local)
+	assert.Len(t, database.ReachIndex.ReachableForwardWithNext("A", "R", at, nil), 2)
+	assert.Empty(t, database.ReachIndex.ReachableForwardWithNext("B", "R", at, nil))
+
+	// only trips within the window, the next day's trip is not used
+	assert.Len(t, database.ReachIndex.ReachableForwardWithin("A", "R", at, at.Add(2*time.Hour), nil), 2)
+	assert.Empty(t, database.ReachIndex.ReachableForwardWithin("A", "R", at, at.Add(time.Hour), nil))
+	assert.Empty(t, database.ReachIndex.ReachableForwardWithin("A", "R", at.Add(2*time.Hour), at.Add(24*time.Hour), nil))
+	assert.Len(t, database.ReachIndex.ReachableBackwardWithin("D", "R", at.Add(2*time.Hour), at, nil), 2)
+	assert.Empty(t, database.ReachIndex.ReachableBackwardWithin("D", "R", at.Add(2*time.Hour), at.Add(90*time.Minute), nil))
+
+	origin, destination := database.ReachIndex.ReachableBetweenWithSchedule("A", "C", "R", nil)
+	assert.Empty(t, origin.After(at, 1))
+	assert.Empty(t, destination.Before(at.Add(24*time.Hour), 1))
+}
+
+func TestReachIndexMixedDropOff(t *testing.T) {
+	// T1 and T2 visit the same stops but T1 does not drop off at B
+	stopTimes := func(tripId string, hour int, dropOff model.StopType) []model.StopTime {
+		return []model.StopTime{
+			{TripId: tripId, StopId: "A", Sequence: 1, Arrival: model.NewTime(hour, 0, 0), Departure: model.NewTime(hour, 0, 0)},
+			{TripId: tripId, StopId: "B", Sequence: 2, Arrival: model.NewTime(hour, 5, 0), Departure: model.NewTime(hour, 5, 0), DropOff: dropOff},
+			{TripId: tripId, StopId: "C", Sequence: 3, Arrival: model.NewTime(hour, 10, 0), Departure: model.NewTime(hour, 10, 0)},
+		}
+	}
+
+	database := NewDB(&model.Dataset{
+		Routes: []model.Route{{Id: "R"}},
+		Services: []model.Service{{
+			Id:    "S",
+			On:    [7]bool{true, true, true, true, true, true, true},
+			Start: time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local),
+			End:   time.Date(2022, 12, 31, 0, 0, 0, 0, time.Local),
+		}},
+		Stops:     []model.Stop{{Id: "A"}, {Id: "B"}, {Id: "C"}},
+		Trips:     []model.Trip{{Id: "T1", RouteId: "R", ServiceId: "S"}, {Id: "T2", RouteId: "R", ServiceId: "S"}},
+		StopTimes: append(stopTimes("T1", 8, model.StopTypeNone), stopTimes("T2", 9, model.StopTypeRegular)...),
+	})
+
+	at := time.Date(2022, 9, 1, 7, 0, 0, 0, time.Local)
+	reachable := map[string]model.ReachableSchedule{}
+	for _, result := range database.ReachIndex.ReachableForwardWithNext("A", "R", at, nil) {
+		reachable[result.Destination.Id] = result
+	}
+
+	// B is reached by the 9:00 trip even though the 8:00 trip departs first
+	assert.Len(t, reachable, 2)
+	assert.Equal(t, "T2", reachable["B"].Trip.Id)
+	assert.Equal(t, time.Date(2022, 9, 1, 9, 5, 0, 0, time.Local), reachable["B"].Arrival)
+	assert.Equal(t, "T1", reachable["C"].Trip.Id)
+
+	// arriving at B before the 9:00 trip isn't possible
+	assert.Empty(t, database.ReachIndex.ReachableBackwardWithin("B", "R", at.Add(90*time.Minute), at, nil))
+	previous := database.ReachIndex.ReachableBackwardWithPrevious("B", "R", at.Add(3*time.Hour), nil)
+	assert.Len(t, previous, 1)
+	assert.Equal(t, "T2", previous[0].Trip.Id)
+}
+
+func TestReachIndexArrivalDeparture(t *testing.T) {
+	// X waits at B so it arrives at B before Y but departs B after Y
+	database := NewDB(&model.Dataset{
+		Routes: []model.Route{{Id: "R"}},
+		Services: []model.Service{{
+			Id:    "S",
+			On:    [7]bool{true, true, true, true, true, true, true},
+			Start: time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local),
+			End:   time.Date(2022, 12, 31, 0, 0, 0, 0, time.Local),
+		}},
+		Stops: []model.Stop{{Id: "A"}, {Id: "B"}, {Id: "C"}},
+		Trips: []model.Trip{{Id: "X", RouteId: "R", ServiceId: "S"}, {Id: "Y", RouteId: "R", ServiceId: "S"}},
+		StopTimes: []model.StopTime{
+			{TripId: "X", StopId: "A", Sequence: 1, Arrival: model.NewTime(7, 50, 0), Departure: model.NewTime(7, 50, 0)},
+			{TripId: "X", StopId: "B", Sequence: 2, Arrival: model.NewTime(8, 0, 0), Departure: model.NewTime(8, 20, 0)},
+			{TripId: "X", StopId: "C", Sequence: 3, Arrival: model.NewTime(8, 30, 0), Departure: model.NewTime(8, 30, 0)},
+			{TripId: "Y", StopId: "A", Sequence: 1, Arrival: model.NewTime(8, 0, 0), Departure: model.NewTime(8, 0, 0)},
+			{TripId: "Y", StopId: "B", Sequence: 2, Arrival: model.NewTime(8, 10, 0), Departure: model.NewTime(8, 11, 0)},
+			{TripId: "Y", StopId: "C", Sequence: 3, Arrival: model.NewTime(8, 21, 0), Departure: model.NewTime(8, 21, 0)},
+		},
+	})
+
+	day := time.Date(2022, 9, 1, 0, 0, 0, 0, time.Local)
+	at := func(hour, minute int) time.Time {
+		return day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+
+	// the latest arrival at B is Y even though X departs B last
+	origin, destination := database.ReachIndex.ReachableBetweenWithSchedule("A", "B", "R", nil)
+	previous, err := destination.Previous(at(8, 15))
+	assert.NoError(t, err)
+	assert.Equal(t, "Y", previous.TripId)
+	assert.Equal(t, at(8, 10), previous.Time)
+
+	next, err := origin.Next(at(7, 55))
+	assert.NoError(t, err)
+	assert.Equal(t, "Y", next.TripId)
+
+	// riders leaving from B depart at the departure, riders getting off at B arrive at the arrival
+	origin, _ = database.ReachIndex.ReachableBetweenWithSchedule("B", "C", "R", nil)
+	next, err = origin.Next(at(8, 5))
+	assert.NoError(t, err)
+	assert.Equal(t, "Y", next.TripId)
+	assert.Equal(t, at(8, 11), next.Time)
+
+	forward := database.ReachIndex.ReachableForwardWithNext("A", "R", at(7, 45), nil)
+	assert.Len(t, forward, 2)
+	for _, result := range forward {
+		if result.Destination.Id == "B" {
+			assert.Equal(t, at(7, 50), result.Departure)
+			assert.Equal(t, at(8, 0), result.Arrival)
+		}
+	}
+
+	backward := database.ReachIndex.ReachableBackwardWithPrevious("C", "R", at(8, 25), nil)
+	assert.Len(t, backward, 2)
+	for _, result := range backward {
+		if result.Origin.Id == "B" {
+			assert.Equal(t, at(8, 11), result.Departure)
+			assert.Equal(t, at(8, 21), result.Arrival)
+		}
+	}
+}
@@ -38,6 +38,15 @@ type Shapes interface {
 	Get(shapeId string) ([]model.Shape, error)
 }
 
+type Transfers interface {
+	Get(from, to model.TransferPoint) (model.Transfer, error)
+	InSeat(tripId string, reverse bool) []model.Transfer
+}
+
+type Pathways interface {
+	Duration(fromStopId, toStopId string, accessible bool) (time.Duration, error)
+}
+
 type StopRoutes interface {
 	Get(stopId string) []model.StopRoute
 }
@@ -67,12 +76,14 @@ type Reachable interface {
 }
 
 type ReachableWithSchedule interface {
-	ReachableForwardWithNext(originId, routeId string, after time.Time) []model.ReachableSchedule
-	ReachableBackwardWithPrevious(originId, routeId string, before time.Time) []model.ReachableSchedule
+	ReachableForwardWithNext(originId, routeId string, after time.Time, filter model.TripFilter) []model.ReachableSchedule
+	ReachableBackwardWithPrevious(originId, routeId string, before time.Time, filter model.TripFilter) []model.ReachableSchedule
+	ReachableForwardWithin(originId, routeId string, after, before time.Time, filter model.TripFilter) []model.ReachableSchedule
+	ReachableBackwardWithin(originId, routeId string, before, after time.Time, filter model.TripFilter) []model.ReachableSchedule
 }
 
 type ReachableBetween interface {
-	ReachableBetweenWithSchedule(originId, destinationId, routeId string) (Schedule, Schedule)
+	ReachableBetweenWithSchedule(originId, destinationId, routeId string, filter model.TripFilter) (Schedule, Schedule)
 }
 
 type Reach interface {
@@ -2,7 +2,7 @@ package db
 
 import (
 	"fmt"
-	"sort"
+	"time"
 
 	"stop-checker.com/db/model"
 	"stop-checker.com/db/repository"
@@ -12,6 +12,7 @@ type indexesRequiredBySchedule struct {
 	trips             repository.Trips
 	services          repository.Services          // services by id
 	serviceExceptions repository.ServiceExceptions // service exceptions by service ID and time
+	location          *time.Location               // timezone of the service days
 }
 
 type ScheduleIndex struct {
@@ -26,11 +27,9 @@ func NewScheduleIndex(stopTimes []model.StopTime, indexes *indexesRequiredBySche
 		return fmt.Sprintf("%s:%s", stopTime.StopId, trip.RouteId)
 	})
 
-	// sort the stop times by arrival time
+	// sort the stop times by departure time
 	for _, schedule := range index.data {
-		sort.Slice(schedule, func(i, j int) bool {
-			return schedule[i].Time < schedule[j].Time
-		})
+		model.StopTimeSort(schedule)
 	}
 
 	return &ScheduleIndex{
@@ -7,9 +7,29 @@ import (
 	"stop-checker.com/db/model"
 )
 
+/* ScheduleResults
+stop times at a stop sorted by departure, or by arrival when only backward queries are made.
+forward queries (After, Next, Day) are for boarding and use the departure time,
+backward queries (Before, Previous) are for alighting and use the arrival time.
+stop times without pickup are skipped by forward queries and stop times without drop off by backward queries.
+times are converted to the agency's timezone so service days don't depend on the caller's timezone
+*/
 type ScheduleResults struct {
 	*indexesRequiredBySchedule
 	results []model.StopTime
+	filter  model.TripFilter // every trip is used when nil
+}
+
+// Filter returns the results that only use the trips kept by the filter
+func (s *ScheduleResults) Filter(filter model.TripFilter) *ScheduleResults {
+	if filter == nil {
+		return s
+	}
+	return &ScheduleResults{
+		indexesRequiredBySchedule: s.indexesRequiredBySchedule,
+		results:                   s.results,
+		filter:                    filter,
+	}
 }
 
 func (s *ScheduleResults) Next(after time.Time) (model.ScheduleResult, error) {
@@ -33,13 +53,14 @@ func (s *ScheduleResults) Previous(before time.Time) (model.ScheduleResult, erro
 }
 
 func (s *ScheduleResults) Before(before time.Time, limit int) []model.ScheduleResult {
-	results := s.beforeWithinDay(before, limit)
+	before = before.In(s.location)
+	results := s.beforeWithinDay(before, time.Time{}, limit)
 
 	before = truncate(before).Add(-time.Second)
 	attempts := 0
 	for len(results) != limit && attempts < 7 {
 		attempts++
-		results = append(results, s.beforeWithinDay(before, limit-len(results))...)
+		results = append(results, s.beforeWithinDay(before, time.Time{}, limit-len(results))...)
 		before = before.AddDate(0, 0, -1)
 	}
 
@@ -48,41 +69,75 @@ func (s *ScheduleResults) Before(before time.Time, limit int) []model.ScheduleRe
 
 // query next N stop times after a specific time
 func (s *ScheduleResults) After(after time.Time, limit int) []model.ScheduleResult {
-	results := s.afterWithinDay(after, limit)
+	after = after.In(s.location)
+	results := s.afterWithinDay(after, time.Time{}, limit)
 
 	attempts := 0
 	for len(results) != limit && attempts < 7 {
 		attempts++
 		after = truncate(after.AddDate(0, 0, 1))
-		results = append(results, s.afterWithinDay(after, limit-len(results))...)
+		results = append(results, s.afterWithinDay(after, time.Time{}, limit-len(results))...)
 	}
 
 	return results
 }
 
+// NextBefore is the next stop time after a time that departs before another time
+func (s *ScheduleResults) NextBefore(after, before time.Time) (model.ScheduleResult, error) {
+	after, before = after.In(s.location), before.In(s.location)
+	results := s.afterWithinDay(after, before, 1)
+
+	// only the days until the end of the window are searched
+	for day := truncate(after.AddDate(0, 0, 1)); len(results) == 0 && day.Before(before); day = truncate(day.AddDate(0, 0, 1)) {
+		results = s.afterWithinDay(day, before, 1)
+	}
+
+	if len(results) == 0 {
+		return model.ScheduleResult{}, errors.New("not found")
+	}
+	return results[0], nil
+}
+
+// PreviousAfter is the previous stop time before a time that arrives after another time
+func (s *ScheduleResults) PreviousAfter(before, after time.Time) (model.ScheduleResult, error) {
+	before, after = before.In(s.location), after.In(s.location)
+	results := s.beforeWithinDay(before, after, 1)
+
+	for day := truncate(before).Add(-time.Second); len(results) == 0 && day.After(after); day = day.AddDate(0, 0, -1) {
+		results = s.beforeWithinDay(day, after, 1)
+	}
+
+	if len(results) == 0 {
+		return model.ScheduleResult{}, errors.New("not found")
+	}
+	return results[0], nil
+}
+
 // query all stop times on a specific date
 func (s *ScheduleResults) Day(on time.Time) []model.ScheduleResult {
-	return s.afterWithinDay(truncate(on), -1)
+	return s.afterWithinDay(truncate(on.In(s.location)), time.Time{}, -1)
 }
 
-func (s *ScheduleResults) afterWithinDay(t time.Time, limit int) []model.ScheduleResult {
+// stop times departing after t on its day. stop times departing at or after until are skipped unless it's zero
+func (s *ScheduleResults) afterWithinDay(t, until time.Time, limit int) []model.ScheduleResult {
 	results := []model.ScheduleResult{}
-	year, month, day := t.Date()
 
 	for _, stopTime := range s.results {
-		if !stopTime.After(t) {
+		if !stopTime.Departure.After(t) || !stopTime.CanBoard() {
 			continue
 		}
 
-		if !s.valid(t, stopTime) {
+		if !until.IsZero() && !stopTime.Departure.On(t).Before(until) {
 			continue
 		}
 
-		dt := time.Date(year, month, day, stopTime.Hour(), stopTime.Minute(), 0, 0, time.Local)
+		if !s.valid(t, stopTime, stopTime.Departure.Days()) {
+			continue
+		}
 
 		results = append(results, model.ScheduleResult{
 			StopTime: stopTime,
-			Time:     dt,
+			Time:     stopTime.Departure.On(t),
 		})
 
 		if len(results) == limit {
@@ -93,22 +148,26 @@ func (s *ScheduleResults) afterWithinDay(t time.Time, limit int) []model.Schedul
 	return results
 }
 
-func (s *ScheduleResults) beforeWithinDay(t time.Time, limit int) []model.ScheduleResult {
+// stop times arriving before t on its day. stop times arriving at or before until are skipped unless it's zero
+func (s *ScheduleResults) beforeWithinDay(t, until time.Time, limit int) []model.ScheduleResult {
 	results := []model.ScheduleResult{}
-	year, month, day := t.Date()
 
 	for _, stopTime := range reverse(s.results) {
-		if !stopTime.Before(t) {
+		if !stopTime.Arrival.Before(t) || !stopTime.CanAlight() {
 			continue
 		}
 
-		if !s.valid(t, stopTime) {
+		if !until.IsZero() && !stopTime.Arrival.On(t).After(until) {
+			continue
+		}
+
+		if !s.valid(t, stopTime, stopTime.Arrival.Days()) {
 			continue
 		}
 
 		results = append(results, model.ScheduleResult{
 			StopTime: stopTime,
-			Time:     time.Date(year, month, day, stopTime.Hour(), stopTime.Minute(), 0, 0, time.Local),
+			Time:     stopTime.Arrival.On(t),
 		})
 
 		if len(results) == limit {
@@ -123,33 +182,33 @@ func (s *ScheduleResults) beforeWithinDay(t time.Time, limit int) []model.Schedu
 - the date is between the service start and end date
 - the service is running on the time's day of the week
 - there on no service exception on the time's date
+- the trip is kept by the filter
+days is the number of days the arrival or departure being checked is past the service day
 */
-func (s *ScheduleResults) valid(t time.Time, stopTime model.StopTime) bool {
-	if stopTime.Overflow {
-		/* stop times can overflow to the next day. service day of
-		2022-08-28 and time of 26:00 means 2AM on 2022-08-29 so to check
-		if the stoptime will happen on the 29th we actually check the 28th*/
-		t = t.Add(-24 * time.Hour)
-	}
+func (s *ScheduleResults) valid(t time.Time, stopTime model.StopTime, days int) bool {
+	/* stop times can overflow to the following days. service day of
+	2022-08-28 and time of 26:00 means 2AM on 2022-08-29 so to check
+	if the stoptime will happen on the 29th we actually check the 28th*/
+	t = t.AddDate(0, 0, -days)
 
 	trip, _ := s.trips.Get(stopTime.TripId)
-	service, _ := s.services.Get(trip.ServiceId)
-
-	if t.Before(service.Start) || t.After(service.End.Add(24*time.Hour)) {
+	if s.filter != nil && !s.filter(trip) {
 		return false
 	}
 
-	// results must have service on the week day
-	if !service.On[t.Weekday()] {
-		return false
+	service, _ := s.services.Get(trip.ServiceId)
+
+	// exceptions add or remove service on the date regardless of the week day and date range
+	if exception, err := s.serviceExceptions.Get(service.Id, t); err == nil {
+		return exception.Added
 	}
 
-	// results must not have exceptions
-	if exception, err := s.serviceExceptions.Get(service.Id, t); err == nil && !exception.Added {
+	if t.Before(service.Start) || t.After(service.End.Add(24*time.Hour)) {
 		return false
 	}
 
-	return true
+	// results must have service on the week day
+	return service.On[t.Weekday()]
 }
 
 // truncate time leaving only the date
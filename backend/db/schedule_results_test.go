@@ -0,0 +1,104 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+func TestScheduleResultsArrivalDeparture(t *testing.T) {
+	// the trip waits at B for 10 minutes
+	database := NewDB(&model.Dataset{
+		Routes: []model.Route{{Id: "R"}},
+		Services: []model.Service{{
+			Id:    "S",
+			On:    [7]bool{true, true, true, true, true, true, true},
+			Start: time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local),
+			End:   time.Date(2022, 12, 31, 0, 0, 0, 0, time.Local),
+		}},
+		Stops: []model.Stop{{Id: "A"}, {Id: "B"}, {Id: "C"}},
+		Trips: []model.Trip{{Id: "T", RouteId: "R", ServiceId: "S"}},
+		StopTimes: []model.StopTime{
+			{TripId: "T", StopId: "A", Sequence: 1, Arrival: model.NewTime(7, 50, 0), Departure: model.NewTime(7, 50, 0)},
+			{TripId: "T", StopId: "B", Sequence: 2, Arrival: model.NewTime(8, 0, 0), Departure: model.NewTime(8, 10, 0)},
+			{TripId: "T", StopId: "C", Sequence: 3, Arrival: model.NewTime(8, 20, 0), Departure: model.NewTime(8, 20, 0)},
+		},
+	})
+
+	day := time.Date(2022, 9, 1, 0, 0, 0, 0, time.Local)
+	at := func(hour, minute int) time.Time {
+		return day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+	schedule := database.ScheduleIndex.Get("B", "R")
+
+	// boarding uses the departure
+	next, err := schedule.Next(at(7, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, at(8, 10), next.Time)
+
+	next, err = schedule.Next(at(8, 5))
+	assert.NoError(t, err)
+	assert.Equal(t, at(8, 10), next.Time)
+
+	// alighting uses the arrival
+	previous, err := schedule.Previous(at(9, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, at(8, 0), previous.Time)
+
+	previous, err = schedule.Previous(at(8, 5))
+	assert.NoError(t, err)
+	assert.Equal(t, at(8, 0), previous.Time)
+
+	previous, err = schedule.Previous(at(7, 55))
+	assert.NoError(t, err)
+	assert.Equal(t, at(8, 0).AddDate(0, 0, -1), previous.Time)
+}
+
+func TestScheduleResultsTimezone(t *testing.T) {
+	// the service runs on saturdays and its trip leaves A at 3 AM on sunday
+	database := NewDB(&model.Dataset{
+		Agencies: []model.Agency{{Id: "A", Timezone: "America/Vancouver"}},
+		Routes:   []model.Route{{Id: "R", AgencyId: "A"}},
+		Services: []model.Service{{
+			Id:    "S",
+			On:    [7]bool{false, false, false, false, false, false, true},
+			Start: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC),
+		}},
+		Stops: []model.Stop{{Id: "A"}, {Id: "B"}},
+		Trips: []model.Trip{{Id: "T", RouteId: "R", ServiceId: "S"}},
+		StopTimes: []model.StopTime{
+			{TripId: "T", StopId: "A", Sequence: 1, Arrival: model.NewTime(27, 0, 0), Departure: model.NewTime(27, 0, 0)},
+			{TripId: "T", StopId: "B", Sequence: 2, Arrival: model.NewTime(27, 30, 0), Departure: model.NewTime(27, 30, 0)},
+		},
+	})
+	assert.Equal(t, "America/Vancouver", database.Location.String())
+
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2022, month, day, hour, 0, 0, 0, database.Location)
+	}
+	schedule := database.ScheduleIndex.Get("A", "R")
+
+	// the times are in the agency's timezone no matter the timezone of the query
+	for _, test := range []struct {
+		name     string
+		after    time.Time
+		expected time.Time
+	}{
+		{"before midnight", at(9, 10, 20).UTC(), at(9, 11, 3)},
+		{"after midnight", at(9, 11, 1).UTC(), at(9, 11, 3)},
+		{"next service day", at(9, 11, 4).UTC(), at(9, 18, 3)},
+		{"clocks go forward", at(3, 13, 0).UTC(), at(3, 13, 3)},
+		{"clocks go back", at(11, 6, 0).UTC(), at(11, 6, 3)},
+	} {
+		next, err := schedule.Next(test.after)
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, test.expected, next.Time, test.name)
+	}
+
+	previous, err := database.ScheduleIndex.Get("B", "R").Previous(at(11, 6, 12).UTC())
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 11, 6, 3, 30, 0, 0, database.Location), previous.Time)
+}
@@ -0,0 +1,333 @@
+package db
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	fp "path/filepath"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"stop-checker.com/db/model"
+)
+
+// incremented whenever the snapshot format or the indexes it contains change
+const snapshotVersion = 2
+
+type snapshotHeader struct {
+	Version int
+	Hash    string // hash of the feeds the snapshot was created from
+}
+
+/* snapshot
+the dataset and the indexes that are slow to build. stop times are stored once in the
+dataset and the indexes refer to them by their position. indexes that are fast to build
+(lookups by id, stop locations, stop text search, transfers) are rebuilt when loading
+*/
+type snapshot struct {
+	Dataset         *model.Dataset
+	StopTimesByTrip map[string][]int32
+	StopRoutes      map[string][]model.StopRoute
+	Schedule        map[string][]int32
+	Reach           reachSnapshot
+}
+
+type reachSnapshot struct {
+	TripsByHash       map[string][]string
+	StopsByHash       map[string]map[string]reachStopSnapshot
+	HashesByStopRoute map[string]map[string]reachStopSnapshot
+	StopTimesByHash   map[string]map[string][]int32
+}
+
+type reachStopSnapshot struct {
+	Index    int
+	Sequence int
+	Board    bool
+	Alight   bool
+}
+
+/* FeedsHash
+identifies the contents of the feeds and their filters. a snapshot can only be used
+when it was created from feeds with the same hash. filters without a start keep the services
+running from today so their hash changes every day
+*/
+func FeedsHash(feeds []Feed) (string, error) {
+	hash := sha256.New()
+
+	for _, feed := range feeds {
+		filter := feed.Filter
+		if filter.Start.IsZero() {
+			// the feed's timezone isn't known before parsing so the host's date is used. the services kept when
+			// the snapshot was created are still a superset of the running services while the host's date is the same
+			now := time.Now()
+			filter.Start = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		}
+		fmt.Fprintf(hash, "%q %q %+v\n", feed.Prefix, feed.Agency, filter)
+
+		info, err := os.Stat(feed.Path)
+		if err != nil {
+			return "", err
+		}
+
+		files := []string{feed.Path}
+		if info.IsDir() {
+			if files, err = fp.Glob(fp.Join(feed.Path, "*.txt")); err != nil {
+				return "", err
+			}
+			sort.Strings(files)
+		}
+
+		for _, path := range files {
+			fmt.Fprintf(hash, "%q\n", fp.Base(path))
+			if err := hashFile(hash, path); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func hashFile(w io.Writer, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(w, file)
+	return err
+}
+
+// WriteSnapshot saves the DB built from the dataset of feeds with the hash
+func WriteSnapshot(path, hash string, database *DB, dataset *model.Dataset) error {
+	t0 := time.Now()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	encoder := gob.NewEncoder(w)
+
+	if err := encoder.Encode(snapshotHeader{Version: snapshotVersion, Hash: hash}); err != nil {
+		return err
+	}
+
+	if err := encoder.Encode(newSnapshot(database, dataset)); err != nil {
+		return err
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	log.Info().Dur("duration", time.Since(t0)).Str("path", path).Msg("wrote DB snapshot")
+	return file.Close()
+}
+
+/* NewDBFromSnapshot
+loads a DB saved by WriteSnapshot. errors when the snapshot has a different version
+or was created from feeds with a different hash
+*/
+func NewDBFromSnapshot(path, hash string) (*DB, *model.Dataset, error) {
+	t0 := time.Now()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	decoder := gob.NewDecoder(bufio.NewReader(file))
+
+	header := snapshotHeader{}
+	if err := decoder.Decode(&header); err != nil {
+		return nil, nil, fmt.Errorf("failed to read snapshot %s: %w", path, err)
+	}
+
+	if header.Version != snapshotVersion {
+		return nil, nil, fmt.Errorf("snapshot %s has version %d, expected %d", path, header.Version, snapshotVersion)
+	}
+
+	if header.Hash != hash {
+		return nil, nil, fmt.Errorf("snapshot %s was created from different feeds", path)
+	}
+
+	s := &snapshot{}
+	if err := decoder.Decode(s); err != nil {
+		return nil, nil, fmt.Errorf("failed to read snapshot %s: %w", path, err)
+	}
+
+	database := newDB(s.Dataset, s)
+
+	log.Info().Dur("duration", time.Since(t0)).Str("path", path).Msg("loaded DB snapshot")
+	return database, s.Dataset, nil
+}
+
+func newSnapshot(database *DB, dataset *model.Dataset) *snapshot {
+	positions := make(map[string]int32, len(dataset.StopTimes))
+	for i, stopTime := range dataset.StopTimes {
+		positions[stopTime.ID()] = int32(i)
+	}
+
+	toPositions := func(stopTimes []model.StopTime) []int32 {
+		results := make([]int32, len(stopTimes))
+		for i, stopTime := range stopTimes {
+			results[i] = positions[stopTime.ID()]
+		}
+		return results
+	}
+
+	s := &snapshot{
+		Dataset:         dataset,
+		StopTimesByTrip: map[string][]int32{},
+		StopRoutes:      database.StopRouteIndex.index.data,
+		Schedule:        map[string][]int32{},
+		Reach: reachSnapshot{
+			TripsByHash:       map[string][]string{},
+			StopsByHash:       map[string]map[string]reachStopSnapshot{},
+			HashesByStopRoute: map[string]map[string]reachStopSnapshot{},
+			StopTimesByHash:   map[string]map[string][]int32{},
+		},
+	}
+
+	for tripId, stopTimes := range database.StopTimesByTrip.data {
+		s.StopTimesByTrip[tripId] = toPositions(stopTimes)
+	}
+
+	for key, stopTimes := range database.ScheduleIndex.index.data {
+		s.Schedule[key] = toPositions(stopTimes)
+	}
+
+	reach := database.ReachIndex
+	for hash, trips := range reach.tripsByHash {
+		for tripId := range trips {
+			s.Reach.TripsByHash[hash] = append(s.Reach.TripsByHash[hash], tripId)
+		}
+	}
+
+	for hash, stops := range reach.stopsByHash {
+		s.Reach.StopsByHash[hash] = toReachStopSnapshots(stops)
+	}
+
+	for stopRoute, hashes := range reach.hashesByStopRoute {
+		s.Reach.HashesByStopRoute[stopRoute] = toReachStopSnapshots(hashes)
+	}
+
+	for stopRoute, schedules := range reach.stopRouteStopTimesByHash {
+		s.Reach.StopTimesByHash[stopRoute] = map[string][]int32{}
+		for hash, schedule := range schedules {
+			s.Reach.StopTimesByHash[stopRoute][hash] = toPositions(schedule.results)
+		}
+	}
+
+	return s
+}
+
+func toReachStopSnapshots(infos map[string]hashStopInfo) map[string]reachStopSnapshot {
+	results := make(map[string]reachStopSnapshot, len(infos))
+	for key, info := range infos {
+		results[key] = reachStopSnapshot{Index: info.index, Sequence: info.sequence, Board: info.board, Alight: info.alight}
+	}
+	return results
+}
+
+func fromReachStopSnapshots(snapshots map[string]reachStopSnapshot) map[string]hashStopInfo {
+	results := make(map[string]hashStopInfo, len(snapshots))
+	for key, s := range snapshots {
+		results[key] = hashStopInfo{index: s.Index, sequence: s.Sequence, board: s.Board, alight: s.Alight}
+	}
+	return results
+}
+
+// stop times at the positions in the dataset
+func (s *snapshot) stopTimes(positions []int32) []model.StopTime {
+	results := make([]model.StopTime, len(positions))
+	for i, position := range positions {
+		results[i] = s.Dataset.StopTimes[position]
+	}
+	return results
+}
+
+func (s *snapshot) stopTimesByTrip(dataset *model.Dataset) *InvertedIndex[model.StopTime] {
+	index := &InvertedIndex[model.StopTime]{
+		name: "stop-times-by-trip",
+		data: make(map[string][]model.StopTime, len(s.StopTimesByTrip)),
+	}
+	for tripId, positions := range s.StopTimesByTrip {
+		index.data[tripId] = s.stopTimes(positions)
+	}
+	return index
+}
+
+func (s *snapshot) stopRoutes(dataset *model.Dataset, trips *Index[model.Trip]) *StopRouteIndex {
+	return &StopRouteIndex{
+		index: &InvertedIndex[model.StopRoute]{
+			name: "stop-routes",
+			data: s.StopRoutes,
+		},
+	}
+}
+
+func (s *snapshot) schedule(dataset *model.Dataset, indexes *indexesRequiredBySchedule) *ScheduleIndex {
+	index := &InvertedIndex[model.StopTime]{
+		name: "schedule",
+		data: make(map[string][]model.StopTime, len(s.Schedule)),
+	}
+	for key, positions := range s.Schedule {
+		index.data[key] = s.stopTimes(positions)
+	}
+
+	return &ScheduleIndex{
+		index:                     index,
+		indexesRequiredBySchedule: indexes,
+	}
+}
+
+func (s *snapshot) reach(dataset *model.Dataset, trips *Index[model.Trip], stops *Index[model.Stop], stopRoutes *StopRouteIndex, stopTimesByTrip *InvertedIndex[model.StopTime], indexes *indexesRequiredBySchedule) *ReachIndex {
+	index := &ReachIndex{
+		trips:                     trips,
+		stops:                     stops,
+		stopTimesByTrip:           stopTimesByTrip,
+		indexesRequiredBySchedule: indexes,
+		tripsByHash:               map[string]map[string]struct{}{},
+		stopsByHash:               map[string]map[string]hashStopInfo{},
+		hashesByStopRoute:         map[string]map[string]hashStopInfo{},
+		stopRouteStopTimesByHash:  map[string]map[string]*ScheduleResults{},
+	}
+
+	for hash, tripIds := range s.Reach.TripsByHash {
+		index.tripsByHash[hash] = make(map[string]struct{}, len(tripIds))
+		for _, tripId := range tripIds {
+			index.tripsByHash[hash][tripId] = struct{}{}
+		}
+	}
+
+	for hash, snapshots := range s.Reach.StopsByHash {
+		index.stopsByHash[hash] = fromReachStopSnapshots(snapshots)
+	}
+
+	for stopRoute, snapshots := range s.Reach.HashesByStopRoute {
+		index.hashesByStopRoute[stopRoute] = fromReachStopSnapshots(snapshots)
+	}
+
+	for stopRoute, hashes := range s.Reach.StopTimesByHash {
+		index.stopRouteStopTimesByHash[stopRoute] = make(map[string]*ScheduleResults, len(hashes))
+		for hash, positions := range hashes {
+			index.stopRouteStopTimesByHash[stopRoute][hash] = &ScheduleResults{
+				indexesRequiredBySchedule: indexes,
+				results:                   s.stopTimes(positions),
+			}
+		}
+	}
+
+	return index
+}
@@ -0,0 +1,75 @@
+package db
+
+import (
+	"os"
+	fp "path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+func TestSnapshot(t *testing.T) {
+	dataset := &model.Dataset{
+		Agencies: []model.Agency{{Id: "A", Name: "Agency", Timezone: "America/Montreal"}},
+		Routes:   []model.Route{{Id: "R"}},
+		Services: []model.Service{{
+			Id:    "S",
+			On:    [7]bool{true, true, true, true, true, true, true},
+			Start: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC),
+		}},
+		Stops: []model.Stop{{Id: "A", Name: "Bank"}, {Id: "B", Name: "Rideau"}},
+		Trips: []model.Trip{{Id: "T1", RouteId: "R", ServiceId: "S"}, {Id: "T2", RouteId: "R", ServiceId: "S"}},
+		StopTimes: []model.StopTime{
+			{TripId: "T1", StopId: "A", Sequence: 1, Arrival: model.NewTime(8, 0, 0), Departure: model.NewTime(8, 0, 0)},
+			{TripId: "T1", StopId: "B", Sequence: 2, Arrival: model.NewTime(8, 5, 0), Departure: model.NewTime(8, 5, 0)},
+			{TripId: "T2", StopId: "A", Sequence: 1, Arrival: model.NewTime(9, 0, 0), Departure: model.NewTime(9, 0, 0)},
+			{TripId: "T2", StopId: "B", Sequence: 2, Arrival: model.NewTime(9, 5, 0), Departure: model.NewTime(9, 5, 0)},
+		},
+	}
+
+	path := fp.Join(t.TempDir(), "db.snapshot")
+	built := NewDB(dataset)
+	assert.NoError(t, WriteSnapshot(path, "hash", built, dataset))
+
+	_, _, err := NewDBFromSnapshot(path, "other")
+	assert.Error(t, err)
+
+	loaded, loadedDataset, err := NewDBFromSnapshot(path, "hash")
+	assert.NoError(t, err)
+	assert.Len(t, loadedDataset.StopTimes, 4)
+	assert.Equal(t, built.Location.String(), loaded.Location.String())
+
+	at := time.Date(2022, 9, 1, 8, 30, 0, 0, built.Location)
+	assert.Equal(t, built.StopRouteIndex.Get("A"), loaded.StopRouteIndex.Get("A"))
+	assert.Equal(t, built.ScheduleIndex.Get("A", "R").After(at, 2), loaded.ScheduleIndex.Get("A", "R").After(at, 2))
+	assert.Equal(t, built.ReachIndex.Reachable("A", "R", false), loaded.ReachIndex.Reachable("A", "R", false))
+	assert.Equal(t, built.ReachIndex.ReachableForwardWithNext("A", "R", at, nil), loaded.ReachIndex.ReachableForwardWithNext("A", "R", at, nil))
+	assert.Equal(t, built.StopTextIndex.Query("bank"), loaded.StopTextIndex.Query("bank"))
+	assert.Equal(t, "stop-routes", built.StopRouteIndex.index.name)
+	assert.Equal(t, "stop-routes", loaded.StopRouteIndex.index.name)
+}
+
+func TestFeedsHash(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(fp.Join(dir, "agency.txt"), []byte("agency_id\n"), 0644))
+
+	hash := func(filter FeedFilter) string {
+		h, err := FeedsHash([]Feed{{Prefix: "oc:", Path: dir, Filter: filter}})
+		assert.NoError(t, err)
+		return h
+	}
+
+	// feeds without a start keep the services from today
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, hash(FeedFilter{Start: today}), hash(FeedFilter{}))
+	assert.NotEqual(t, hash(FeedFilter{Start: today.AddDate(0, 0, -1)}), hash(FeedFilter{}))
+
+	// the contents of the files
+	before := hash(FeedFilter{})
+	assert.NoError(t, os.WriteFile(fp.Join(dir, "agency.txt"), []byte("agency_id\nA\n"), 0644))
+	assert.NotEqual(t, before, hash(FeedFilter{}))
+}
@@ -27,11 +27,12 @@ func NewStopRouteIndex(trips *Index[model.Trip], stopTimes []model.StopTime) *St
 		}
 		stopRoutes[stopId][routeId] = stopRouteInfo{
 			directionId: trip.DirectionId,
-			headsign:    trip.Headsign,
+			headsign:    headsign(trip, stopTime),
 		}
 	}
 
 	index := &InvertedIndex[model.StopRoute]{
+		name: "stop-routes",
 		data: map[string][]model.StopRoute{},
 	}
 
@@ -57,3 +58,11 @@ func (s *StopRouteIndex) Get(stopId string) []model.StopRoute {
 	results, _ := s.index.Get(stopId)
 	return results
 }
+
+// the stop headsign overrides the trip headsign, loop routes change headsign partway through the trip
+func headsign(trip model.Trip, stopTime model.StopTime) string {
+	if stopTime.Headsign != "" {
+		return stopTime.Headsign
+	}
+	return trip.Headsign
+}
@@ -19,12 +19,16 @@ type StopTextIndex struct {
 	stopsByToken      map[string][]model.Stop
 	stopsByCode       repository.InvertedIndex[model.Stop]
 	stopRoutes        repository.StopRoutes
+	stops             repository.Stops
+	stopsByParent     repository.InvertedIndex[model.Stop]
 	removePunctuation *regexp.Regexp
 }
 
 func NewStopTextIndex(
 	stopsByCode repository.InvertedIndex[model.Stop],
 	stopRoutes repository.StopRoutes,
+	stopsById repository.Stops,
+	stopsByParent repository.InvertedIndex[model.Stop],
 	stops []model.Stop,
 ) *StopTextIndex {
 	re, _ := regexp.Compile(`[^\w]`)
@@ -33,6 +37,8 @@ func NewStopTextIndex(
 		stopsByCode:       stopsByCode,
 		stopsByToken:      map[string][]model.Stop{},
 		stopRoutes:        stopRoutes,
+		stops:             stopsById,
+		stopsByParent:     stopsByParent,
 		removePunctuation: re,
 	}
 
@@ -54,8 +60,16 @@ func (s *StopTextIndex) Query(text string) []model.Stop {
 	resultsMap := map[string]*StopTextResult{}
 
 	for _, token := range tokens {
+		matched := map[string]struct{}{} // platforms of the same station match a token once
+
 		if stops, err := s.stopsByCode.Get(token); err == nil {
 			for _, stop := range stops {
+				stop = s.station(stop)
+				if _, ok := matched[stop.ID()]; ok {
+					continue
+				}
+				matched[stop.ID()] = struct{}{}
+
 				if result, tracked := resultsMap[stop.ID()]; !tracked {
 					resultsMap[stop.ID()] = &StopTextResult{
 						Stop:           stop,
@@ -72,6 +86,12 @@ func (s *StopTextIndex) Query(text string) []model.Stop {
 		stops := s.stopsByToken[token]
 
 		for _, stop := range stops {
+			stop = s.station(stop)
+			if _, ok := matched[stop.ID()]; ok {
+				continue
+			}
+			matched[stop.ID()] = struct{}{}
+
 			if result, tracked := resultsMap[stop.ID()]; !tracked {
 				resultsMap[stop.ID()] = &StopTextResult{
 					Stop:           stop,
@@ -104,9 +124,7 @@ func (s *StopTextIndex) Query(text string) []model.Stop {
 		}
 
 		if ri.MatchingTokens == rj.MatchingTokens {
-			riRoutes := s.stopRoutes.Get(ri.ID())
-			rjRoutes := s.stopRoutes.Get(rj.ID())
-			return len(riRoutes) > len(rjRoutes)
+			return s.routeCount(ri.Stop) > s.routeCount(rj.Stop)
 		}
 
 		return ri.MatchingTokens > rj.MatchingTokens
@@ -120,6 +138,30 @@ func (s *StopTextIndex) Query(text string) []model.Stop {
 
 }
 
+// collapse a platform into its station
+func (s *StopTextIndex) station(stop model.Stop) model.Stop {
+	if stop.Parent == "" {
+		return stop
+	}
+
+	station, err := s.stops.Get(stop.Parent)
+	if err != nil || !station.IsStation() {
+		return stop
+	}
+	return station
+}
+
+// number of routes at a stop or the platforms of a station
+func (s *StopTextIndex) routeCount(stop model.Stop) int {
+	count := len(s.stopRoutes.Get(stop.ID()))
+
+	platforms, _ := s.stopsByParent.Get(stop.ID())
+	for _, platform := range platforms {
+		count += len(s.stopRoutes.Get(platform.ID()))
+	}
+	return count
+}
+
 func (s *StopTextIndex) tokenize(text string) []string {
 	text = strings.ToLower(text)
 
@@ -0,0 +1,42 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+type stopRoutesByStop map[string][]model.StopRoute
+
+func (s stopRoutesByStop) Get(stopId string) []model.StopRoute {
+	return s[stopId]
+}
+
+func TestStopTextIndexCollapsesPlatforms(t *testing.T) {
+	stops := []model.Stop{
+		{Id: "TP", Name: "Tunney's Pasture", Type: "1"},
+		{Id: "TP1", Name: "Tunney's Pasture", Code: "3011", Parent: "TP"},
+		{Id: "TP2", Name: "Tunney's Pasture", Code: "3011", Parent: "TP"},
+		{Id: "P", Name: "Pasture Way"},
+	}
+
+	stopsById := NewIndex("stops", stops, func(stop model.Stop) string { return stop.ID() })
+	stopsByCode := NewInvertedIndex("stops-by-code", stops, func(stop model.Stop) string { return stop.Code })
+	stopsByParent := NewInvertedIndex("stops-by-parent", stops[1:3], func(stop model.Stop) string { return stop.Parent })
+	stopRoutes := stopRoutesByStop{
+		"TP1": {{RouteId: "1"}},
+		"TP2": {{RouteId: "2"}},
+		"P":   {{RouteId: "3"}},
+	}
+
+	index := NewStopTextIndex(stopsByCode, stopRoutes, stopsById, stopsByParent, stops)
+
+	results := index.Query("pasture")
+	assert.Len(t, results, 2)
+	assert.Equal(t, "TP", results[0].Id) // the station has more routes
+
+	results = index.Query("3011")
+	assert.Len(t, results, 1)
+	assert.Equal(t, "TP", results[0].Id)
+}
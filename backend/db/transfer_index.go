@@ -0,0 +1,138 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+
+	"stop-checker.com/db/model"
+	"stop-checker.com/db/repository"
+)
+
+/* TransferIndex
+transfers from transfers.txt by the alighting and boarding stop. transfers from a station
+apply to all of its platforms and transfers without stops apply everywhere (in-seat transfers between trips).
+transfers of a trip in frequencies.txt apply to every trip expanded from it
+*/
+type TransferIndex struct {
+	stops      repository.Stops
+	trips      repository.Trips
+	index      *InvertedIndex[model.Transfer]
+	inSeatFrom *InvertedIndex[model.Transfer] // in-seat transfers by the trip they continue from
+	inSeatTo   *InvertedIndex[model.Transfer] // in-seat transfers by the trip they continue to
+}
+
+func NewTransferIndex(transfers []model.Transfer, stops repository.Stops, trips repository.Trips) *TransferIndex {
+	inSeat := []model.Transfer{}
+	for _, transfer := range transfers {
+		if transfer.Type == model.TransferInSeat && transfer.From.TripId != "" && transfer.To.TripId != "" {
+			inSeat = append(inSeat, transfer)
+		}
+	}
+
+	return &TransferIndex{
+		stops: stops,
+		trips: trips,
+		index: NewInvertedIndex("transfers", transfers, func(transfer model.Transfer) string {
+			return transferKey(transfer.From.StopId, transfer.To.StopId)
+		}),
+		inSeatFrom: NewInvertedIndex("in-seat-transfers-from", inSeat, func(transfer model.Transfer) string {
+			return transfer.From.TripId
+		}),
+		inSeatTo: NewInvertedIndex("in-seat-transfers-to", inSeat, func(transfer model.Transfer) string {
+			return transfer.To.TripId
+		}),
+	}
+}
+
+/* InSeat
+transfers continuing from a trip, or continuing to the trip when reversed. the trips of the
+transfers are GTFS trip IDs so they match every trip expanded from a trip in frequencies.txt
+*/
+func (t *TransferIndex) InSeat(tripId string, reverse bool) []model.Transfer {
+	index := t.inSeatFrom
+	if reverse {
+		index = t.inSeatTo
+	}
+	transfers, _ := index.Get(t.gtfsTripId(tripId))
+	return transfers
+}
+
+// trip_id of the trip in transfers.txt
+func (t *TransferIndex) gtfsTripId(tripId string) string {
+	if trip, err := t.trips.Get(tripId); err == nil {
+		return trip.GTFSId()
+	}
+	return tripId
+}
+
+func transferKey(fromStopId, toStopId string) string {
+	return fmt.Sprintf("%s:%s", fromStopId, toStopId)
+}
+
+/* Get the most specific transfer between two points
+trips are more specific than routes and routes more specific than stops:
+https://gtfs.org/schedule/reference/#transferstxt
+*/
+func (t *TransferIndex) Get(from, to model.TransferPoint) (model.Transfer, error) {
+	from.TripId = t.gtfsTripId(from.TripId)
+	to.TripId = t.gtfsTripId(to.TripId)
+
+	var best model.Transfer
+	bestScore := -1
+
+	for _, fromStopId := range t.candidateStops(from.StopId) {
+		for _, toStopId := range t.candidateStops(to.StopId) {
+			transfers, _ := t.index.Get(transferKey(fromStopId, toStopId))
+
+			for _, transfer := range transfers {
+				score, ok := transferScore(transfer, from, to)
+				if ok && score > bestScore {
+					best = transfer
+					bestScore = score
+				}
+			}
+		}
+	}
+
+	if bestScore < 0 {
+		return model.Transfer{}, errors.New("transfer not found")
+	}
+	return best, nil
+}
+
+// the stop, its station and any stop
+func (t *TransferIndex) candidateStops(stopId string) []string {
+	candidates := []string{stopId}
+	if stop, err := t.stops.Get(stopId); err == nil && stop.Parent != "" {
+		candidates = append(candidates, stop.Parent)
+	}
+	return append(candidates, "")
+}
+
+// specificity of a transfer that matches the points
+func transferScore(transfer model.Transfer, from, to model.TransferPoint) (int, bool) {
+	score := 0
+	for _, side := range [][2]model.TransferPoint{{transfer.From, from}, {transfer.To, to}} {
+		rule, point := side[0], side[1]
+
+		if rule.TripId != "" {
+			if rule.TripId != point.TripId {
+				return 0, false
+			}
+			score += 10
+		}
+
+		if rule.RouteId != "" {
+			if rule.RouteId != point.RouteId {
+				return 0, false
+			}
+			score += 3
+		}
+
+		// exact stops are more specific than stations
+		if rule.StopId != "" && rule.StopId == point.StopId {
+			score++
+		}
+	}
+	return score, true
+}
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+func TestTransferIndex(t *testing.T) {
+	stops := NewIndex("stops", []model.Stop{
+		{Id: "A1", Parent: "A"},
+		{Id: "A2", Parent: "A"},
+	}, func(stop model.Stop) string { return stop.ID() })
+
+	// trips expanded from frequencies.txt
+	trips := NewIndex("trips", []model.Trip{
+		{Id: "T3@08:00:00", TemplateId: "T3"},
+		{Id: "T4@08:30:00", TemplateId: "T4"},
+	}, func(trip model.Trip) string { return trip.ID() })
+
+	index := NewTransferIndex([]model.Transfer{
+		{From: model.TransferPoint{StopId: "A"}, To: model.TransferPoint{StopId: "A"}, Type: model.TransferMinimumTime, MinTime: 3 * time.Minute},
+		{From: model.TransferPoint{StopId: "A1", RouteId: "1"}, To: model.TransferPoint{StopId: "A2", RouteId: "2"}, Type: model.TransferForbidden},
+		{From: model.TransferPoint{TripId: "T1"}, To: model.TransferPoint{TripId: "T2"}, Type: model.TransferInSeat},
+		{From: model.TransferPoint{TripId: "T3"}, To: model.TransferPoint{TripId: "T4"}, Type: model.TransferInSeat},
+		{From: model.TransferPoint{StopId: "A1", TripId: "T3"}, To: model.TransferPoint{StopId: "A2"}, Type: model.TransferTimed},
+	}, stops, trips)
+
+	// station transfer applies to its platforms
+	transfer, err := index.Get(model.TransferPoint{StopId: "A1", RouteId: "3"}, model.TransferPoint{StopId: "A2", RouteId: "2"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3*time.Minute, transfer.MinTime)
+
+	// routes are more specific than stations
+	transfer, err = index.Get(model.TransferPoint{StopId: "A1", RouteId: "1"}, model.TransferPoint{StopId: "A2", RouteId: "2"})
+	assert.NoError(t, err)
+	assert.Equal(t, model.TransferForbidden, transfer.Type)
+
+	_, err = index.Get(model.TransferPoint{StopId: "B"}, model.TransferPoint{StopId: "C"})
+	assert.Error(t, err)
+
+	assert.Len(t, index.InSeat("T1", false), 1)
+	assert.Len(t, index.InSeat("T1", true), 0)
+	assert.Len(t, index.InSeat("T2", true), 1)
+
+	// rules for trips in frequencies.txt apply to the trips expanded from them
+	assert.Len(t, index.InSeat("T3@08:00:00", false), 1)
+	assert.Len(t, index.InSeat("T4@08:30:00", true), 1)
+	transfer, err = index.Get(model.TransferPoint{StopId: "A1", RouteId: "1", TripId: "T3@08:00:00"}, model.TransferPoint{StopId: "A2", RouteId: "2"})
+	assert.NoError(t, err)
+	assert.Equal(t, model.TransferTimed, transfer.Type)
+}
+
+func TestPathwayIndex(t *testing.T) {
+	stopsByParent := NewInvertedIndex("stops-by-parent", []model.Stop{
+		{Id: "P2-boarding", Parent: "P2"},
+	}, func(stop model.Stop) string { return stop.Parent })
+
+	index := NewPathwayIndex([]model.Pathway{
+		{FromStopId: "P1", ToStopId: "N", Bidirectional: true, TraversalTime: 40 * time.Second, Mode: model.PathwayModeStairs},
+		{FromStopId: "N", ToStopId: "P2-boarding", Length: 65},
+		{FromStopId: "P1", ToStopId: "P2", TraversalTime: 5 * time.Minute},
+		{FromStopId: "P1", ToStopId: "P3", Mode: model.PathwayModeEscalator},
+	}, stopsByParent)
+
+	duration, err := index.Duration("P1", "P2", false)
+	assert.NoError(t, err)
+	assert.Equal(t, 90*time.Second, duration)
+
+	_, err = index.Duration("P2", "P1", false)
+	assert.Error(t, err)
+
+	// wheelchairs avoid the stairs and can't use the escalator
+	duration, err = index.Duration("P1", "P2", true)
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, duration)
+
+	_, err = index.Duration("P1", "P3", true)
+	assert.ErrorIs(t, err, model.ErrInaccessiblePathway)
+
+	_, err = index.Duration("P2", "P1", true)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, model.ErrInaccessiblePathway)
+}
@@ -0,0 +1,201 @@
+package gtfsrt
+
+import (
+	"sync"
+
+	rt "github.com/MobilityData/gtfs-realtime-bindings/golang/gtfs"
+	"github.com/rs/zerolog/log"
+	"stop-checker.com/db/model"
+)
+
+/* Alerts
+the latest ServiceAlerts feed. alerts are matched to the stops, routes and trips of their
+informed entities. entities with a stop and a route only apply to the route at the stop,
+entities with only an agency apply to the whole agency and are only in All. entities of
+frequency-based trips also match the trip starting at their start_time
+*/
+type Alerts struct {
+	lock       sync.RWMutex
+	prefix     string // prefix of the IDs of the static feed
+	alerts     []model.Alert
+	stops      map[string][]model.Alert
+	routes     map[string][]model.Alert
+	stopRoutes map[stopRoute][]model.Alert
+	trips      map[string][]model.Alert
+}
+
+type stopRoute struct {
+	stopId  string
+	routeId string
+}
+
+func NewAlerts(prefix string) *Alerts {
+	return &Alerts{
+		lock:       sync.RWMutex{},
+		prefix:     prefix,
+		stops:      map[string][]model.Alert{},
+		routes:     map[string][]model.Alert{},
+		stopRoutes: map[stopRoute][]model.Alert{},
+		trips:      map[string][]model.Alert{},
+	}
+}
+
+// Load replaces the alerts with the alerts of the feed message
+func (a *Alerts) Load(message *rt.FeedMessage) {
+	alerts := []model.Alert{}
+	stops := map[string][]model.Alert{}
+	routes := map[string][]model.Alert{}
+	stopRoutes := map[stopRoute][]model.Alert{}
+	trips := map[string][]model.Alert{}
+
+	for _, entity := range message.GetEntity() {
+		alert := entity.GetAlert()
+		if alert == nil || entity.GetIsDeleted() {
+			continue
+		}
+
+		parsed := parseAlert(entity.GetId(), alert)
+		alerts = append(alerts, parsed)
+
+		for _, informed := range alert.GetInformedEntity() {
+			stopId := a.id(informed.GetStopId())
+			routeId := a.id(informed.GetRouteId())
+			tripId := a.id(informed.GetTrip().GetTripId())
+
+			switch {
+			case tripId != "":
+				trips[tripId] = appendAlert(trips[tripId], parsed)
+
+				// frequency-based trips are expanded into a trip for each start time
+				if start := informed.GetTrip().GetStartTime(); start != "" {
+					trips[tripId+"@"+start] = appendAlert(trips[tripId+"@"+start], parsed)
+				}
+			case stopId != "" && routeId != "":
+				key := stopRoute{stopId, routeId}
+				stopRoutes[key] = appendAlert(stopRoutes[key], parsed)
+			case stopId != "":
+				stops[stopId] = appendAlert(stops[stopId], parsed)
+			case routeId != "":
+				routes[routeId] = appendAlert(routes[routeId], parsed)
+			}
+		}
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.alerts = alerts
+	a.stops = stops
+	a.routes = routes
+	a.stopRoutes = stopRoutes
+	a.trips = trips
+
+	log.Info().
+		Int("alerts", len(alerts)).
+		Time("timestamp", timestamp(message.GetHeader().GetTimestamp())).
+		Msg("loaded GTFS-Realtime alerts")
+}
+
+// All alerts in the feed
+func (a *Alerts) All() []model.Alert {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return a.alerts
+}
+
+// Stop alerts for every route at the stop
+func (a *Alerts) Stop(stopId string) []model.Alert {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return a.stops[stopId]
+}
+
+// Route alerts for every stop of the route
+func (a *Alerts) Route(routeId string) []model.Alert {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return a.routes[routeId]
+}
+
+// StopRoute alerts of the stop, the route, and the route at the stop
+func (a *Alerts) StopRoute(stopId, routeId string) []model.Alert {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	alerts := []model.Alert{}
+	for _, alert := range a.stops[stopId] {
+		alerts = appendAlert(alerts, alert)
+	}
+	for _, alert := range a.routes[routeId] {
+		alerts = appendAlert(alerts, alert)
+	}
+	for _, alert := range a.stopRoutes[stopRoute{stopId, routeId}] {
+		alerts = appendAlert(alerts, alert)
+	}
+	return alerts
+}
+
+// Trip alerts of the trip
+func (a *Alerts) Trip(tripId string) []model.Alert {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return a.trips[tripId]
+}
+
+func (a *Alerts) id(id string) string {
+	if id == "" {
+		return id
+	}
+	return a.prefix + id
+}
+
+// append the alert unless an alert has the same id. alerts can inform the same entity several times
+func appendAlert(alerts []model.Alert, alert model.Alert) []model.Alert {
+	for _, existing := range alerts {
+		if existing.Id == alert.Id {
+			return alerts
+		}
+	}
+	return append(alerts, alert)
+}
+
+func parseAlert(id string, alert *rt.Alert) model.Alert {
+	parsed := model.Alert{
+		Id:          id,
+		Header:      translate(alert.GetHeaderText()),
+		Description: translate(alert.GetDescriptionText()),
+		URL:         translate(alert.GetUrl()),
+		Cause:       alert.GetCause().String(),
+		Effect:      alert.GetEffect().String(),
+	}
+
+	for _, period := range alert.GetActivePeriod() {
+		parsed.Periods = append(parsed.Periods, model.AlertPeriod{
+			Start: timestamp(period.GetStart()),
+			End:   timestamp(period.GetEnd()),
+		})
+	}
+
+	return parsed
+}
+
+// english text, the text without a language, or the first translation
+func translate(s *rt.TranslatedString) string {
+	translations := s.GetTranslation()
+	if len(translations) == 0 {
+		return ""
+	}
+
+	for _, language := range []string{"en", ""} {
+		for _, translation := range translations {
+			if translation.GetLanguage() == language {
+				return translation.GetText()
+			}
+		}
+	}
+	return translations[0].GetText()
+}
@@ -0,0 +1,69 @@
+package gtfsrt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+func alertIds(alerts []model.Alert) []string {
+	ids := []string{}
+	for _, alert := range alerts {
+		ids = append(ids, alert.Id)
+	}
+	return ids
+}
+
+func TestAlerts(t *testing.T) {
+	message, err := Read("testdata/alerts.pb")
+	assert.NoError(t, err)
+
+	alerts := NewAlerts("oc:")
+	alerts.Load(message)
+
+	assert.Equal(t, []string{"stop-closed", "detour", "stop-route", "trip", "agency", "frequency-trip"}, alertIds(alerts.All()))
+	assert.Equal(t, []string{"stop-closed"}, alertIds(alerts.Stop("oc:S1")))
+	assert.Equal(t, []string{"detour"}, alertIds(alerts.Route("oc:95")))
+	assert.Equal(t, []string{"trip"}, alertIds(alerts.Trip("oc:T1")))
+	assert.Equal(t, []string{"detour", "stop-route"}, alertIds(alerts.StopRoute("oc:S2", "oc:95")))
+	assert.Empty(t, alerts.Stop("oc:S2"))
+
+	// frequency-based trips also match the trip expanded at the start time
+	assert.Equal(t, []string{"frequency-trip"}, alertIds(alerts.Trip("oc:F1@08:00:00")))
+	assert.Equal(t, []string{"frequency-trip"}, alertIds(alerts.Trip("oc:F1")))
+	assert.Empty(t, alerts.Trip("oc:F1@08:10:00"))
+
+	// english is preferred over other languages
+	closed := alerts.Stop("oc:S1")[0]
+	assert.Equal(t, "Stop closed", closed.Header)
+	assert.Equal(t, "CONSTRUCTION", closed.Cause)
+	assert.Equal(t, "NO_SERVICE", closed.Effect)
+	assert.Equal(t, "Arrêt déplacé", alerts.StopRoute("oc:S2", "oc:95")[1].Header)
+
+	detour := alerts.Route("oc:95")[0]
+	assert.Equal(t, "Buses will not serve Main Street", detour.Description)
+	assert.Equal(t, "https://example.com/detour", detour.URL)
+}
+
+func TestAlertActive(t *testing.T) {
+	message, err := Read("testdata/alerts.pb")
+	assert.NoError(t, err)
+
+	alerts := NewAlerts("")
+	alerts.Load(message)
+
+	closed := alerts.Stop("S1")[0]
+	assert.False(t, closed.Active(time.Unix(1662970000, 0)))
+	assert.True(t, closed.Active(time.Unix(1662990000, 0)))
+	assert.False(t, closed.Active(time.Unix(1663000001, 0)))
+	assert.True(t, closed.During(time.Unix(1662970000, 0), time.Unix(1662985000, 0)))
+
+	// alerts without periods are always active, periods without an end never end
+	assert.True(t, alerts.Route("95")[0].Active(time.Unix(0, 0)))
+	agency := alerts.All()[4]
+	assert.False(t, agency.Active(time.Unix(1662990000, 0)))
+	assert.True(t, agency.Active(time.Unix(1763000000, 0)))
+	assert.True(t, agency.During(time.Unix(1662990000, 0), time.Time{}))
+}
@@ -0,0 +1,78 @@
+package gtfsrt
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	rt "github.com/MobilityData/gtfs-realtime-bindings/golang/gtfs"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+)
+
+var client = &http.Client{Timeout: time.Second * 30}
+
+// Read a GTFS-Realtime feed message from an http(s) URL or a file
+func Read(source string) (*rt.FeedMessage, error) {
+	data, err := readSource(source)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &rt.FeedMessage{}
+	if err := proto.Unmarshal(data, message); err != nil {
+		return nil, fmt.Errorf("failed to parse GTFS-Realtime feed %s: %w", source, err)
+	}
+	return message, nil
+}
+
+func readSource(source string) ([]byte, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return os.ReadFile(source)
+	}
+
+	res, err := client.Get(source)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to request GTFS-Realtime feed %s: %s", source, res.Status)
+	}
+	return io.ReadAll(res.Body)
+}
+
+/* Poll
+reads the feed at the source every interval and passes it to load. errors are logged and the
+previous data is kept. the first read happens before returning
+*/
+func Poll(source string, interval time.Duration, load func(message *rt.FeedMessage)) {
+	poll := func() {
+		t0 := time.Now()
+		message, err := Read(source)
+		if err != nil {
+			log.Error().Err(err).Dur("request-duration", time.Since(t0)).Str("source", source).Msg("failed to read GTFS-Realtime feed")
+			return
+		}
+		load(message)
+	}
+
+	poll()
+	go func() {
+		for range time.Tick(interval) {
+			poll()
+		}
+	}()
+}
+
+// time of a POSIX timestamp from the feed, zero when the timestamp is missing
+func timestamp(seconds uint64) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(seconds), 0)
+}
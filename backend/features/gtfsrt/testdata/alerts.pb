@@ -0,0 +1,29 @@
+
+
+2.0W
+stop-closed*H
+**S1**S10
+8R(
+
+Arrêt ferméfr
+
+Stop closedenp
+detour*f*958B
+
+https://example.com/detourR
+
+Detour on route 95Z$
+"
+ Buses will not serve Main Street2
+
+stop-route*$*95*S2R
+
+Arrêt déplacéfr
+trip*
+*"
+T18
+agency*
+*
+OC&
+frequency-trip**"
+F108:00:008
\ No newline at end of file
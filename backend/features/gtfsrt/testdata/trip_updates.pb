@@ -0,0 +1,19 @@
+
+
+2.0)
+1$
+
+T120220912x
+
+2
+
+T220220912 !
+3
+
+F108:00:0020220912(<
+4
+
+X1 ,
+5'
+
+T320220912"B"C("D(
\ No newline at end of file
@@ -0,0 +1,318 @@
+package gtfsrt
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	rt "github.com/MobilityData/gtfs-realtime-bindings/golang/gtfs"
+	"github.com/rs/zerolog/log"
+	"stop-checker.com/db/model"
+)
+
+/* TripUpdate
+realtime changes to a trip from a TripUpdates feed. stop time updates are matched
+to the stop times of the trip by stop sequence, or by stop id when the feed omits it
+*/
+type TripUpdate struct {
+	TripId    string    // id of the trip in the DB including the feed prefix
+	Date      time.Time // service day of the trip at midnight UTC, zero when the feed omits start_date
+	Canceled  bool
+	Timestamp time.Time
+
+	delay *time.Duration // delay of the whole trip, used until the first stop time update
+	stops []stopTimeUpdate
+}
+
+type stopTimeUpdate struct {
+	sequence  int // -1 when the feed omits stop_sequence
+	stopId    string
+	arrival   stopTimeEvent
+	departure stopTimeEvent
+	skipped   bool
+	noData    bool // predictions are unknown from this stop until the next update
+}
+
+type stopTimeEvent struct {
+	time  time.Time // zero when the feed only has a delay
+	delay *time.Duration
+}
+
+/* TripUpdates
+the latest TripUpdates feed. trips are keyed by their id in the DB, updates for
+frequency-based trips also match the trip starting at the update's start_time
+*/
+type TripUpdates struct {
+	lock      sync.RWMutex
+	prefix    string // prefix of the IDs of the static feed
+	trips     map[string]*TripUpdate
+	timestamp time.Time
+}
+
+func NewTripUpdates(prefix string) *TripUpdates {
+	return &TripUpdates{
+		lock:   sync.RWMutex{},
+		prefix: prefix,
+		trips:  map[string]*TripUpdate{},
+	}
+}
+
+// Load replaces the updates with the trip updates of the feed message
+func (t *TripUpdates) Load(message *rt.FeedMessage) {
+	trips := map[string]*TripUpdate{}
+	unmatched := 0
+
+	for _, entity := range message.GetEntity() {
+		update := entity.GetTripUpdate()
+		if update == nil || entity.GetIsDeleted() {
+			continue
+		}
+
+		// trips that are not in the static feed can't be matched
+		descriptor := update.GetTrip()
+		if descriptor.GetTripId() == "" || descriptor.GetScheduleRelationship() == rt.TripDescriptor_ADDED {
+			unmatched++
+			continue
+		}
+
+		tripUpdate := t.parseTripUpdate(update)
+		trips[tripUpdate.TripId] = tripUpdate
+
+		// frequency-based trips are expanded into a trip for each start time
+		if descriptor.GetStartTime() != "" {
+			trips[tripUpdate.TripId+"@"+descriptor.GetStartTime()] = tripUpdate
+		}
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.trips = trips
+	t.timestamp = timestamp(message.GetHeader().GetTimestamp())
+
+	log.Info().
+		Int("trips", len(trips)).
+		Int("trips-unmatched", unmatched).
+		Time("timestamp", t.timestamp).
+		Msg("loaded GTFS-Realtime trip updates")
+}
+
+// Get the update of a trip
+func (t *TripUpdates) Get(tripId string) (*TripUpdate, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	update, ok := t.trips[tripId]
+	return update, ok
+}
+
+// Timestamp of the feed message that was loaded
+func (t *TripUpdates) Timestamp() time.Time {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return t.timestamp
+}
+
+/* Predictor
+predicts trips from the latest updates with service days in the timezone of a static feed.
+each DB has its own so a reloaded feed in another timezone doesn't change the predictions
+of requests still using the old DB
+*/
+type Predictor struct {
+	updates  *TripUpdates
+	location *time.Location // timezone of the static feed's service days
+}
+
+func (t *TripUpdates) Predictor(location *time.Location) *Predictor {
+	return &Predictor{updates: t, location: location}
+}
+
+/* Predict
+the arrival and departure at each scheduled stop time of a trip. the stop times must be from
+the same trip. delays carry over from the last stop with an update to the following stops.
+false when the trip has no update
+*/
+func (p *Predictor) Predict(scheduled []model.StopTime) ([]model.Prediction, bool) {
+	if len(scheduled) == 0 {
+		return nil, false
+	}
+
+	update, ok := p.updates.Get(scheduled[0].TripId)
+	if !ok {
+		return nil, false
+	}
+
+	var date time.Time
+	if update.Date.IsZero() {
+		date = serviceDay(scheduled, time.Now().In(p.location))
+	} else {
+		date = time.Date(update.Date.Year(), update.Date.Month(), update.Date.Day(), 0, 0, 0, 0, p.location)
+	}
+
+	return update.predict(scheduled, date), true
+}
+
+/* serviceDay
+service day of a trip updated without a start date. feeds have updates for trips that are running
+or about to, so it's the service day (yesterday or today) of the trip starting closest to now.
+trips past 24:00 running after midnight started on yesterday's service day
+*/
+func serviceDay(scheduled []model.StopTime, now time.Time) time.Time {
+	first := scheduled[0].Departure
+	for _, stopTime := range scheduled {
+		if stopTime.Departure < first {
+			first = stopTime.Departure
+		}
+	}
+
+	today := truncate(now)
+	yesterday := today.AddDate(0, 0, -1)
+
+	// yesterday's trip started closer to now than today's trip starts
+	if now.Sub(first.OnServiceDay(yesterday)) < abs(first.OnServiceDay(today).Sub(now)) {
+		return yesterday
+	}
+	return today
+}
+
+func abs(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+func (u *TripUpdate) predict(scheduled []model.StopTime, date time.Time) []model.Prediction {
+	stopTimes := make([]model.StopTime, len(scheduled))
+	copy(stopTimes, scheduled)
+	sort.Slice(stopTimes, func(i, j int) bool {
+		return stopTimes[i].Sequence < stopTimes[j].Sequence
+	})
+
+	bySequence := map[int]stopTimeUpdate{}
+	byStop := map[string]stopTimeUpdate{}
+	for _, stop := range u.stops {
+		if stop.sequence >= 0 {
+			bySequence[stop.sequence] = stop
+		} else {
+			byStop[stop.stopId] = stop
+		}
+	}
+
+	delay := u.delay
+	predictions := make([]model.Prediction, len(stopTimes))
+
+	for i, stopTime := range stopTimes {
+		prediction := model.Prediction{StopTime: stopTime, Date: date, Skipped: u.Canceled}
+		arrival := stopTime.Arrival.OnServiceDay(date)
+		departure := stopTime.Departure.OnServiceDay(date)
+
+		stop, ok := bySequence[stopTime.Sequence]
+		if !ok {
+			stop, ok = byStop[stopTime.StopId]
+		}
+
+		switch {
+		case u.Canceled:
+		case ok && stop.skipped:
+			prediction.Skipped = true
+		case ok && stop.noData:
+			delay = nil
+		default:
+			if ok {
+				delay = stop.arrival.resolve(arrival, delay)
+			}
+			if delay == nil {
+				break
+			}
+			prediction.Arrival = arrival.Add(*delay)
+
+			if ok {
+				delay = stop.departure.resolve(departure, delay)
+			}
+			prediction.Departure = departure.Add(*delay)
+			if prediction.Departure.Before(prediction.Arrival) {
+				prediction.Departure = prediction.Arrival
+			}
+
+			prediction.Delay = prediction.Departure.Sub(departure)
+			prediction.Predicted = true
+		}
+
+		predictions[i] = prediction
+	}
+
+	return predictions
+}
+
+// delay of the event compared to the scheduled time, the previous delay when the event has no prediction
+func (e stopTimeEvent) resolve(scheduled time.Time, previous *time.Duration) *time.Duration {
+	if !e.time.IsZero() {
+		delay := e.time.Sub(scheduled)
+		return &delay
+	}
+	if e.delay != nil {
+		return e.delay
+	}
+	return previous
+}
+
+func (t *TripUpdates) parseTripUpdate(update *rt.TripUpdate) *TripUpdate {
+	descriptor := update.GetTrip()
+
+	tripUpdate := &TripUpdate{
+		TripId:    t.prefix + descriptor.GetTripId(),
+		Canceled:  descriptor.GetScheduleRelationship() == rt.TripDescriptor_CANCELED,
+		Timestamp: timestamp(update.GetTimestamp()),
+	}
+
+	if date, err := time.Parse("20060102", descriptor.GetStartDate()); err == nil {
+		tripUpdate.Date = date
+	}
+
+	if update.Delay != nil {
+		delay := time.Duration(update.GetDelay()) * time.Second
+		tripUpdate.delay = &delay
+	}
+
+	for _, stop := range update.GetStopTimeUpdate() {
+		stopUpdate := stopTimeUpdate{
+			sequence:  -1,
+			arrival:   parseStopTimeEvent(stop.GetArrival()),
+			departure: parseStopTimeEvent(stop.GetDeparture()),
+			skipped:   stop.GetScheduleRelationship() == rt.TripUpdate_StopTimeUpdate_SKIPPED,
+			noData:    stop.GetScheduleRelationship() == rt.TripUpdate_StopTimeUpdate_NO_DATA,
+		}
+		if stop.StopSequence != nil {
+			stopUpdate.sequence = int(stop.GetStopSequence())
+		}
+		if stop.GetStopId() != "" {
+			stopUpdate.stopId = t.prefix + stop.GetStopId()
+		}
+
+		tripUpdate.stops = append(tripUpdate.stops, stopUpdate)
+	}
+
+	return tripUpdate
+}
+
+func parseStopTimeEvent(event *rt.TripUpdate_StopTimeEvent) stopTimeEvent {
+	result := stopTimeEvent{}
+	if event == nil {
+		return result
+	}
+
+	if event.Time != nil {
+		result.time = time.Unix(event.GetTime(), 0)
+	}
+	if event.Delay != nil {
+		delay := time.Duration(event.GetDelay()) * time.Second
+		result.delay = &delay
+	}
+	return result
+}
+
+func truncate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
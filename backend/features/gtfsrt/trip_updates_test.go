@@ -0,0 +1,124 @@
+package gtfsrt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+func loadTripUpdates(t *testing.T) *TripUpdates {
+	message, err := Read("testdata/trip_updates.pb")
+	assert.NoError(t, err)
+
+	updates := NewTripUpdates("oc:")
+	updates.Load(message)
+	return updates
+}
+
+func toronto(t *testing.T) *time.Location {
+	location, err := time.LoadLocation("America/Toronto")
+	assert.NoError(t, err)
+	return location
+}
+
+// stop times every 2 minutes from 8:00 with stops A, B, C...
+func scheduledTrip(tripId string, stops int) []model.StopTime {
+	stopTimes := []model.StopTime{}
+	for i := 0; i < stops; i++ {
+		t := model.NewTime(8, i*2, 0)
+		stopTimes = append(stopTimes, model.StopTime{
+			TripId:    tripId,
+			StopId:    "oc:" + string(rune('A'+i)),
+			Sequence:  i + 1,
+			Arrival:   t,
+			Departure: t,
+		})
+	}
+	return stopTimes
+}
+
+func TestTripUpdates(t *testing.T) {
+	updates := loadTripUpdates(t)
+	assert.Equal(t, time.Unix(1662984000, 0), updates.Timestamp())
+
+	_, ok := updates.Get("oc:X1")
+	assert.False(t, ok, "trips added by the feed are not in the DB")
+
+	update, ok := updates.Get("oc:T1")
+	assert.True(t, ok)
+	assert.Equal(t, "2022-09-12", update.Date.Format("2006-01-02"))
+
+	predictions, ok := updates.Predictor(toronto(t)).Predict(scheduledTrip("oc:T1", 5))
+	assert.True(t, ok)
+
+	delays := []time.Duration{}
+	for _, prediction := range predictions {
+		assert.True(t, prediction.Predicted == (prediction.StopTime.Sequence > 1))
+		delays = append(delays, prediction.Delay)
+	}
+
+	// no prediction before the first update, then the delay carries over until the next update
+	assert.Equal(t, []time.Duration{0, 2 * time.Minute, 2 * time.Minute, 4*time.Minute + 30*time.Second, 4*time.Minute + 30*time.Second}, delays)
+	assert.Equal(t, "08:10:30", predictions[3].Departure.Format("15:04:05"))
+	assert.Equal(t, "08:08:00", predictions[3].Arrival.Format("15:04:05"), "arrival uses the delay of the previous stop")
+
+	// the service day is in the timezone of the predictor's static feed
+	assert.True(t, time.Date(2022, 9, 12, 8, 4, 0, 0, toronto(t)).Equal(predictions[1].Departure))
+	predictions, _ = updates.Predictor(time.UTC).Predict(scheduledTrip("oc:T1", 5))
+	assert.True(t, time.Date(2022, 9, 12, 8, 4, 0, 0, time.UTC).Equal(predictions[1].Departure))
+}
+
+func TestTripUpdatesCanceledAndSkipped(t *testing.T) {
+	updates := loadTripUpdates(t).Predictor(toronto(t))
+
+	predictions, ok := updates.Predict(scheduledTrip("oc:T2", 3))
+	assert.True(t, ok)
+	for _, prediction := range predictions {
+		assert.True(t, prediction.Skipped)
+		assert.False(t, prediction.Predicted)
+	}
+
+	// stop time updates matched by stop id
+	predictions, _ = updates.Predict(scheduledTrip("oc:T3", 5))
+	assert.Equal(t, 30*time.Second, predictions[1].Delay)
+	assert.True(t, predictions[2].Skipped)
+	assert.False(t, predictions[3].Predicted, "no data")
+	assert.False(t, predictions[4].Predicted, "no data carries over")
+
+	// frequency-based trips match by start time and use the delay of the whole trip
+	predictions, ok = updates.Predict(scheduledTrip("oc:F1@08:00:00", 2))
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, predictions[0].Delay)
+	assert.True(t, predictions[0].Predicted)
+
+	_, ok = updates.Predict(scheduledTrip("oc:T4", 2))
+	assert.False(t, ok)
+}
+
+func TestServiceDay(t *testing.T) {
+	location := toronto(t)
+	today := time.Date(2022, 9, 12, 0, 0, 0, 0, location)
+	yesterday := today.AddDate(0, 0, -1)
+
+	trip := func(hour, minute int) []model.StopTime {
+		return []model.StopTime{
+			{Sequence: 2, Departure: model.NewTime(hour, minute+20, 0)},
+			{Sequence: 1, Departure: model.NewTime(hour, minute, 0)},
+		}
+	}
+	now := func(hour, minute int) time.Time {
+		return time.Date(2022, 9, 12, hour, minute, 0, 0, location)
+	}
+
+	// trips past midnight started yesterday
+	assert.Equal(t, yesterday, serviceDay(trip(24, 30), now(0, 10)))
+	assert.Equal(t, yesterday, serviceDay(trip(25, 0), now(1, 10)))
+	assert.Equal(t, yesterday, serviceDay(trip(23, 50), now(0, 5)))
+
+	assert.Equal(t, today, serviceDay(trip(8, 0), now(0, 10)))
+	assert.Equal(t, today, serviceDay(trip(8, 0), now(8, 10)))
+	assert.Equal(t, today, serviceDay(trip(23, 50), now(23, 40)))
+	assert.Equal(t, today, serviceDay(trip(0, 20), now(0, 10)))
+}
@@ -0,0 +1,127 @@
+package gtfsrt
+
+import (
+	"sync"
+	"time"
+
+	rt "github.com/MobilityData/gtfs-realtime-bindings/golang/gtfs"
+	"github.com/rs/zerolog/log"
+	"stop-checker.com/db/model"
+)
+
+/* VehiclePositions
+the latest VehiclePositions feed. vehicles are keyed by the id of their trip in the DB,
+vehicles on frequency-based trips also match the trip starting at their start_time
+*/
+type VehiclePositions struct {
+	lock     sync.RWMutex
+	prefix   string // prefix of the IDs of the static feed
+	vehicles []model.Vehicle
+	trips    map[string]model.Vehicle
+}
+
+func NewVehiclePositions(prefix string) *VehiclePositions {
+	return &VehiclePositions{
+		lock:   sync.RWMutex{},
+		prefix: prefix,
+		trips:  map[string]model.Vehicle{},
+	}
+}
+
+// Load replaces the vehicles with the vehicle positions of the feed message
+func (v *VehiclePositions) Load(message *rt.FeedMessage) {
+	vehicles := []model.Vehicle{}
+	trips := map[string]model.Vehicle{}
+	updated := timestamp(message.GetHeader().GetTimestamp())
+
+	for _, entity := range message.GetEntity() {
+		position := entity.GetVehicle()
+		if position == nil || position.GetPosition() == nil || entity.GetIsDeleted() {
+			continue
+		}
+
+		vehicle := v.parseVehicle(entity.GetId(), position, updated)
+		vehicles = append(vehicles, vehicle)
+
+		if vehicle.TripId == "" {
+			continue
+		}
+		trips[vehicle.TripId] = vehicle
+
+		// frequency-based trips are expanded into a trip for each start time
+		if start := position.GetTrip().GetStartTime(); start != "" {
+			trips[vehicle.TripId+"@"+start] = vehicle
+		}
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.vehicles = vehicles
+	v.trips = trips
+
+	log.Info().
+		Int("vehicles", len(vehicles)).
+		Time("timestamp", updated).
+		Msg("loaded GTFS-Realtime vehicle positions")
+}
+
+// Trip gets the vehicle on the trip
+func (v *VehiclePositions) Trip(tripId string) (model.Vehicle, bool) {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	vehicle, ok := v.trips[tripId]
+	return vehicle, ok
+}
+
+// Vehicles gets every vehicle in the feed
+func (v *VehiclePositions) Vehicles() []model.Vehicle {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	return v.vehicles
+}
+
+func (v *VehiclePositions) parseVehicle(entityId string, position *rt.VehiclePosition, updated time.Time) model.Vehicle {
+	descriptor := position.GetVehicle()
+
+	vehicle := model.Vehicle{
+		Id:    descriptor.GetId(),
+		Label: descriptor.GetLabel(),
+		Location: model.Location{
+			Latitude:  float64(position.GetPosition().GetLatitude()),
+			Longitude: float64(position.GetPosition().GetLongitude()),
+		},
+		LastUpdated: updated,
+	}
+
+	// the entity id identifies the vehicle when the descriptor is missing
+	if vehicle.Id == "" {
+		vehicle.Id = entityId
+	}
+
+	if position.Timestamp != nil {
+		vehicle.LastUpdated = timestamp(position.GetTimestamp())
+	}
+
+	if tripId := position.GetTrip().GetTripId(); tripId != "" {
+		vehicle.TripId = v.prefix + tripId
+	}
+
+	if routeId := position.GetTrip().GetRouteId(); routeId != "" {
+		vehicle.RouteId = v.prefix + routeId
+	}
+
+	if position.GetPosition().Bearing != nil {
+		bearing := float64(position.GetPosition().GetBearing())
+		vehicle.Bearing = &bearing
+	}
+
+	if position.GetPosition().Speed != nil {
+		speed := float64(position.GetPosition().GetSpeed())
+		vehicle.Speed = &speed
+	}
+
+	return vehicle
+}
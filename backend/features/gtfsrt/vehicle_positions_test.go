@@ -0,0 +1,39 @@
+package gtfsrt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVehiclePositions(t *testing.T) {
+	message, err := Read("testdata/vehicle_positions.pb")
+	assert.NoError(t, err)
+
+	positions := NewVehiclePositions("oc:")
+	positions.Load(message)
+
+	// entities without a position are skipped
+	assert.Len(t, positions.Vehicles(), 3)
+
+	vehicle, ok := positions.Trip("oc:T1")
+	assert.True(t, ok)
+	assert.Equal(t, "4601", vehicle.Id)
+	assert.Equal(t, "oc:95", vehicle.RouteId)
+	assert.InDelta(t, 45.4215, vehicle.Location.Latitude, 0.0001)
+	assert.Equal(t, 90.0, *vehicle.Bearing)
+	assert.Equal(t, 12.5, *vehicle.Speed)
+	assert.Equal(t, time.Unix(1662983990, 0), vehicle.LastUpdated)
+
+	// vehicles without a descriptor or timestamp use the entity id and the feed timestamp
+	vehicle, ok = positions.Trip("oc:T2")
+	assert.True(t, ok)
+	assert.Equal(t, "e2", vehicle.Id)
+	assert.Equal(t, "", vehicle.RouteId)
+	assert.Nil(t, vehicle.Bearing)
+	assert.Equal(t, time.Unix(1662984000, 0), vehicle.LastUpdated)
+
+	_, ok = positions.Trip("oc:T3")
+	assert.False(t, ok)
+}
@@ -1,6 +1,7 @@
 package octranspo
 
 import (
+	"container/list"
 	"errors"
 	"fmt"
 	"sync"
@@ -10,37 +11,95 @@ import (
 	"stop-checker.com/db/model"
 )
 
+// expired data is served while it's refreshed for at most this long
+const MAX_STALE = 5 * time.Minute
+
+// failed requests for a stop are retried after a backoff doubling from MIN_BACKOFF up to MAX_BACKOFF
+const MIN_BACKOFF = 10 * time.Second
+const MAX_BACKOFF = 5 * time.Minute
+
+type requester interface {
+	Request(stop model.Stop) (map[string][]model.Bus, error)
+}
+
 type entry struct {
-	Routes  map[string][]model.Bus
-	Error   error
-	Ready   sync.WaitGroup
-	Created time.Time
+	stop     model.Stop
+	routes   map[string][]model.Bus // data of the last successful request, nil before the first success
+	err      error                  // error of the last request, nil when it succeeded
+	updated  time.Time              // time of the last successful request
+	failures int                    // consecutive failed requests
+	retry    time.Time              // no requests before this time after a failure
+	request  chan struct{}          // closed when the request in progress finishes, nil without a request
+	element  *list.Element          // position in the LRU list
 }
 
+/* API
+cache of live bus data by stop. at most size stops are kept and the least recently used are evicted.
+expired data is served while it's refreshed in the background. errors are cached and requests
+for the stop are retried with an exponential backoff
+*/
 type API struct {
-	lock   sync.Mutex
-	client *Client
-	data   map[string]*entry
-	ttl    time.Duration
+	lock    sync.Mutex
+	client  requester
+	data    map[string]*entry // entries by stop code
+	lru     *list.List        // stop codes from the most to the least recently used
+	ttl     time.Duration
+	size    int
+	metrics APIMetrics
 }
 
-func NewAPI(ttl time.Duration, client *Client) *API {
+func NewAPI(ttl time.Duration, size int, client requester, metrics APIMetrics) *API {
 	return &API{
-		lock:   sync.Mutex{},
-		client: client,
-		data:   map[string]*entry{},
-		ttl:    ttl,
+		lock:    sync.Mutex{},
+		client:  client,
+		data:    map[string]*entry{},
+		lru:     list.New(),
+		ttl:     ttl,
+		size:    size,
+		metrics: metrics,
 	}
 }
 
 func (api *API) StopData(stop model.Stop) (map[string][]model.Bus, error) {
+	api.lock.Lock()
 	entry := api.getEntry(stop)
-	entry.Ready.Wait()
+	age := time.Since(entry.updated)
+	routes := entry.routes
+
+	switch {
+	case routes != nil && age <= api.ttl:
+		api.lock.Unlock()
+		api.metrics.RecordLookup(CacheHit)
+		return routes, nil
+
+	case routes != nil && age <= api.ttl+MAX_STALE:
+		if !time.Now().Before(entry.retry) {
+			api.update(entry)
+		}
+		api.lock.Unlock()
+		api.metrics.RecordLookup(CacheStale)
+		return routes, nil
+
+	case entry.request == nil && time.Now().Before(entry.retry):
+		err := entry.err
+		api.lock.Unlock()
+		api.metrics.RecordLookup(CacheError)
+		return nil, err
+	}
 
-	if entry.Error != nil {
-		return nil, entry.Error
+	// wait for the request
+	request := api.update(entry)
+	api.lock.Unlock()
+	api.metrics.RecordLookup(CacheMiss)
+	<-request
+
+	api.lock.Lock()
+	defer api.lock.Unlock()
+
+	if entry.err != nil {
+		return nil, entry.err
 	}
-	return entry.Routes, nil
+	return entry.routes, nil
 }
 
 // Request by stop code and route name
@@ -58,62 +117,84 @@ func (api *API) StopRouteData(stop model.Stop, routeName string, routeDirection
 	return buses, nil
 }
 
+// get the entry of the stop and mark it as the most recently used. the lock must be held
 func (api *API) getEntry(stop model.Stop) *entry {
-	api.lock.Lock()
-	defer api.lock.Unlock()
-
-	entry, err := api.getCurrentEntry(stop.Code)
-	if err != nil {
-		return api.update(stop)
+	if entry, ok := api.data[stop.Code]; ok {
+		api.lru.MoveToFront(entry.element)
+		return entry
 	}
-	return entry
-}
 
-func (api *API) getCurrentEntry(stopCode string) (*entry, error) {
-	entry, ok := api.data[stopCode]
-	if !ok {
-		return nil, errors.New("not found")
-	}
+	entry := &entry{stop: stop}
+	entry.element = api.lru.PushFront(stop.Code)
+	api.data[stop.Code] = entry
 
-	if time.Since(entry.Created) > api.ttl {
-		return nil, errors.New("TTL exceeded")
+	// evict the least recently used stops, requests in progress still finish for their callers
+	for api.lru.Len() > api.size {
+		oldest := api.lru.Back()
+		api.lru.Remove(oldest)
+		delete(api.data, oldest.Value.(string))
+		api.metrics.RecordEviction()
 	}
 
-	return entry, nil
+	return entry
 }
 
-func (api *API) update(stop model.Stop) *entry {
-	entry := &entry{
-		Routes:  nil,
-		Error:   nil,
-		Created: time.Now(),
-		Ready:   sync.WaitGroup{},
+// request the stop's data unless a request is in progress. the lock must be held
+func (api *API) update(entry *entry) chan struct{} {
+	if entry.request != nil {
+		return entry.request
 	}
 
-	entry.Ready.Add(1)
-
-	api.data[stop.Code] = entry
+	request := make(chan struct{})
+	entry.request = request
 
 	go func() {
 		t0 := time.Now()
-		routes, err := api.client.Request(stop)
-		entry.Routes = routes
-		entry.Error = err
-		entry.Ready.Done()
+		routes, err := api.client.Request(entry.stop)
+		api.metrics.RecordRequest(time.Since(t0), err)
+
+		api.lock.Lock()
+		entry.err = err
+		if err != nil {
+			entry.failures++
+			entry.retry = time.Now().Add(backoff(entry.failures))
+		} else {
+			entry.routes = routes
+			entry.updated = t0
+			entry.failures = 0
+			entry.retry = time.Time{}
+		}
+		entry.request = nil
+		failures := entry.failures
+		api.lock.Unlock()
+		close(request)
 
 		if err != nil {
 			log.Error().Err(err).
 				Dur("request-duration", time.Since(t0)).
-				Str("request-stop", stop.Code).
+				Str("request-stop", entry.stop.Code).
+				Int("request-failures", failures).
 				Msg("failed to request live bus data from OC Transpo")
 			return
 		}
 
 		log.Info().
 			Dur("request-duration", time.Since(t0)).
-			Str("request-stop", stop.Code).
+			Str("request-stop", entry.stop.Code).
 			Msg("requested live bus data from OC Transpo")
 	}()
 
-	return entry
+	return request
+}
+
+// time to wait after consecutive failures
+func backoff(failures int) time.Duration {
+	duration := MIN_BACKOFF
+	for i := 1; i < failures && duration < MAX_BACKOFF; i++ {
+		duration *= 2
+	}
+	if duration > MAX_BACKOFF {
+		return MAX_BACKOFF
+	}
+	return duration
 }
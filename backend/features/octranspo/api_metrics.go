@@ -0,0 +1,103 @@
+package octranspo
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type CacheResult int
+
+const (
+	CacheHit   CacheResult = iota // fresh data
+	CacheStale                    // expired data served while it's refreshed
+	CacheMiss                     // waited for a request to OC Transpo
+	CacheError                    // error of a recent request served while backing off
+)
+
+type APIMetrics interface {
+	RecordLookup(result CacheResult)
+	RecordRequest(duration time.Duration, err error)
+	RecordEviction()
+}
+
+type APIMetricsEmpty struct {
+}
+
+func (m *APIMetricsEmpty) RecordLookup(result CacheResult) {
+}
+
+func (m *APIMetricsEmpty) RecordRequest(duration time.Duration, err error) {
+}
+
+func (m *APIMetricsEmpty) RecordEviction() {
+}
+
+/* APIMetricsCounter
+counts cache lookups and requests to OC Transpo. Log writes the counts and rates every interval
+*/
+type APIMetricsCounter struct {
+	lookups   [4]int64 // lookups by CacheResult
+	requests  int64
+	errors    int64
+	evictions int64
+}
+
+func (m *APIMetricsCounter) RecordLookup(result CacheResult) {
+	atomic.AddInt64(&m.lookups[result], 1)
+}
+
+func (m *APIMetricsCounter) RecordRequest(duration time.Duration, err error) {
+	atomic.AddInt64(&m.requests, 1)
+	if err != nil {
+		atomic.AddInt64(&m.errors, 1)
+	}
+}
+
+func (m *APIMetricsCounter) RecordEviction() {
+	atomic.AddInt64(&m.evictions, 1)
+}
+
+// Log the metrics every interval. the counts are reset after logging
+func (m *APIMetricsCounter) Log(interval time.Duration) {
+	go func() {
+		for range time.Tick(interval) {
+			m.log()
+		}
+	}()
+}
+
+func (m *APIMetricsCounter) log() {
+	hits := atomic.SwapInt64(&m.lookups[CacheHit], 0)
+	stale := atomic.SwapInt64(&m.lookups[CacheStale], 0)
+	misses := atomic.SwapInt64(&m.lookups[CacheMiss], 0)
+	cachedErrors := atomic.SwapInt64(&m.lookups[CacheError], 0)
+	requests := atomic.SwapInt64(&m.requests, 0)
+	errors := atomic.SwapInt64(&m.errors, 0)
+	evictions := atomic.SwapInt64(&m.evictions, 0)
+
+	lookups := hits + stale + misses + cachedErrors
+	if lookups == 0 && requests == 0 {
+		return
+	}
+
+	log.Info().
+		Int64("cache-hits", hits).
+		Int64("cache-stale", stale).
+		Int64("cache-misses", misses).
+		Int64("cache-errors", cachedErrors).
+		Int64("cache-evictions", evictions).
+		Float64("cache-hit-rate", rate(hits+stale, lookups)).
+		Int64("requests", requests).
+		Int64("request-errors", errors).
+		Float64("request-error-rate", rate(errors, requests)).
+		Msg("OC Transpo API metrics")
+}
+
+func rate(count, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) / float64(total)
+}
@@ -0,0 +1,118 @@
+package octranspo
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+type testRequester struct {
+	lock     sync.Mutex
+	requests map[string]int
+	fail     bool
+}
+
+func (t *testRequester) Request(stop model.Stop) (map[string][]model.Bus, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.requests[stop.Code]++
+	if t.fail {
+		return nil, errors.New("unavailable")
+	}
+	return map[string][]model.Bus{"95:0": {{Headsign: "Barrhaven"}}}, nil
+}
+
+func (t *testRequester) count(stopCode string) int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.requests[stopCode]
+}
+
+// wait for background requests to finish
+func waitForRequest(api *API, stopCode string) {
+	api.lock.Lock()
+	entry, ok := api.data[stopCode]
+	var request chan struct{}
+	if ok {
+		request = entry.request
+	}
+	api.lock.Unlock()
+
+	if request != nil {
+		<-request
+	}
+}
+
+func TestAPIStaleWhileRevalidate(t *testing.T) {
+	client := &testRequester{requests: map[string]int{}}
+	metrics := &APIMetricsCounter{}
+	api := NewAPI(time.Minute, 10, client, metrics)
+	stop := model.Stop{Code: "3000"}
+
+	_, err := api.StopData(stop)
+	assert.NoError(t, err)
+	_, err = api.StopData(stop)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, client.count("3000"))
+
+	// expired data is served while it's refreshed
+	api.data["3000"].updated = time.Now().Add(-2 * time.Minute)
+	client.fail = true
+	buses, err := api.StopRouteData(stop, "95", "0")
+	assert.NoError(t, err)
+	assert.Equal(t, "Barrhaven", buses[0].Headsign)
+	waitForRequest(api, "3000")
+	assert.Equal(t, 2, client.count("3000"))
+
+	// the failed refresh backs off and the stale data is still served
+	_, err = api.StopData(stop)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, client.count("3000"))
+
+	assert.Equal(t, [4]int64{1, 2, 1, 0}, metrics.lookups)
+	assert.Equal(t, int64(1), metrics.errors)
+}
+
+func TestAPIErrors(t *testing.T) {
+	client := &testRequester{requests: map[string]int{}, fail: true}
+	api := NewAPI(time.Minute, 10, client, &APIMetricsEmpty{})
+	stop := model.Stop{Code: "3000"}
+
+	// errors are cached until the backoff ends
+	_, err := api.StopData(stop)
+	assert.Error(t, err)
+	_, err = api.StopData(stop)
+	assert.Error(t, err)
+	assert.Equal(t, 1, client.count("3000"))
+
+	api.data["3000"].retry = time.Now()
+	client.fail = false
+	_, err = api.StopData(stop)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, client.count("3000"))
+
+	assert.Equal(t, 20*time.Second, backoff(2))
+	assert.Equal(t, MAX_BACKOFF, backoff(10))
+}
+
+func TestAPIEviction(t *testing.T) {
+	client := &testRequester{requests: map[string]int{}}
+	api := NewAPI(time.Minute, 2, client, &APIMetricsEmpty{})
+
+	api.StopData(model.Stop{Code: "1"})
+	api.StopData(model.Stop{Code: "2"})
+	api.StopData(model.Stop{Code: "1"})
+	api.StopData(model.Stop{Code: "3"})
+
+	// the least recently used stop was evicted
+	assert.Len(t, api.data, 2)
+	api.StopData(model.Stop{Code: "1"})
+	api.StopData(model.Stop{Code: "2"})
+	assert.Equal(t, 1, client.count("1"))
+	assert.Equal(t, 2, client.count("2"))
+}
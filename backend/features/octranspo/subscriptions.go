@@ -0,0 +1,167 @@
+package octranspo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"stop-checker.com/db/model"
+)
+
+type stopData interface {
+	StopData(stop model.Stop) (map[string][]model.Bus, error)
+}
+
+/* Subscriptions
+live bus data pushed to subscribers. each stop with subscribers is polled once every interval
+no matter how many subscribers it has, subscribers are only sent the buses of their route when they change
+*/
+type Subscriptions struct {
+	lock     sync.Mutex
+	api      stopData
+	interval time.Duration
+	stops    map[string]*stopSubscribers // subscribers by stop code
+}
+
+type stopSubscribers struct {
+	stop        model.Stop
+	routes      map[string][]model.Bus // latest data, nil before the first poll
+	subscribers map[*subscriber]bool
+	done        chan struct{} // closed when the last subscriber leaves
+}
+
+type subscriber struct {
+	route string // route name and direction
+	buses chan []model.Bus
+	last  []model.Bus // nil before the first push
+}
+
+func NewSubscriptions(api stopData, interval time.Duration) *Subscriptions {
+	return &Subscriptions{
+		lock:     sync.Mutex{},
+		api:      api,
+		interval: interval,
+		stops:    map[string]*stopSubscribers{},
+	}
+}
+
+/* Subscribe
+to the buses of a route arriving at a stop. the channel receives the buses when they change
+and is closed when the context is done. slow subscribers only receive the latest buses
+*/
+func (s *Subscriptions) Subscribe(ctx context.Context, stop model.Stop, routeName, routeDirection string) <-chan []model.Bus {
+	sub := &subscriber{
+		route: fmt.Sprintf("%s:%s", routeName, routeDirection),
+		buses: make(chan []model.Bus, 1),
+	}
+
+	s.lock.Lock()
+	subscribers, ok := s.stops[stop.Code]
+	if !ok {
+		subscribers = &stopSubscribers{
+			stop:        stop,
+			subscribers: map[*subscriber]bool{},
+			done:        make(chan struct{}),
+		}
+		s.stops[stop.Code] = subscribers
+		go s.poll(subscribers)
+	}
+	subscribers.subscribers[sub] = true
+	if subscribers.routes != nil {
+		sub.push(subscribers.routes)
+	}
+	s.lock.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		s.unsubscribe(subscribers, sub)
+	}()
+
+	return sub.buses
+}
+
+func (s *Subscriptions) unsubscribe(subscribers *stopSubscribers, sub *subscriber) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(subscribers.subscribers, sub)
+	close(sub.buses)
+
+	// stop polling
+	if len(subscribers.subscribers) == 0 {
+		delete(s.stops, subscribers.stop.Code)
+		close(subscribers.done)
+	}
+}
+
+// poll the stop until it has no subscribers. errors are logged by the API and the previous data is kept
+func (s *Subscriptions) poll(subscribers *stopSubscribers) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if routes, err := s.api.StopData(subscribers.stop); err == nil {
+			s.publish(subscribers, routes)
+		}
+
+		select {
+		case <-subscribers.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Subscriptions) publish(subscribers *stopSubscribers, routes map[string][]model.Bus) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	subscribers.routes = routes
+	for sub := range subscribers.subscribers {
+		sub.push(routes)
+	}
+}
+
+// send the buses of the subscriber's route if they changed, replacing buses that were not received yet
+func (sub *subscriber) push(routes map[string][]model.Bus) {
+	buses := routes[sub.route]
+	if buses == nil {
+		buses = []model.Bus{}
+	}
+
+	if sub.last != nil && sameBuses(sub.last, buses) {
+		return
+	}
+	sub.last = buses
+
+	select {
+	case <-sub.buses:
+	default:
+	}
+	sub.buses <- buses
+}
+
+// arrival and last updated times are relative to the request so they are compared by the minute
+func sameBuses(a, b []model.Bus) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Headsign != b[i].Headsign ||
+			!a[i].Arrival.Truncate(time.Minute).Equal(b[i].Arrival.Truncate(time.Minute)) ||
+			!a[i].LastUpdated.Truncate(time.Minute).Equal(b[i].LastUpdated.Truncate(time.Minute)) ||
+			!sameLocation(a[i].Location, b[i].Location) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameLocation(a, b *model.Location) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,64 @@
+package octranspo
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+type testStopData struct {
+	requests int32
+}
+
+func (t *testStopData) StopData(stop model.Stop) (map[string][]model.Bus, error) {
+	requests := atomic.AddInt32(&t.requests, 1)
+	arrival := time.Date(2022, 9, 12, 8, int(requests), 0, 0, time.UTC)
+
+	return map[string][]model.Bus{
+		"95:0": {{Headsign: "Barrhaven", Arrival: arrival}},
+		"61:1": {{Headsign: "Stittsville"}},
+	}, nil
+}
+
+func TestSubscriptions(t *testing.T) {
+	api := &testStopData{}
+	subscriptions := NewSubscriptions(api, 20*time.Millisecond)
+	stop := model.Stop{Code: "3000"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := subscriptions.Subscribe(ctx, stop, "95", "0")
+	second := subscriptions.Subscribe(ctx, stop, "95", "0")
+	other := subscriptions.Subscribe(ctx, stop, "61", "1")
+	missing := subscriptions.Subscribe(ctx, stop, "12", "0")
+
+	// every subscriber receives the first poll
+	buses := <-first
+	assert.Equal(t, "Barrhaven", buses[0].Headsign)
+	assert.Equal(t, buses, <-second)
+	assert.Equal(t, "Stittsville", (<-other)[0].Headsign)
+	assert.Empty(t, <-missing)
+
+	// only changed buses are pushed
+	assert.NotEqual(t, buses[0].Arrival, (<-first)[0].Arrival)
+	select {
+	case <-other:
+		t.Error("unchanged buses were pushed")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// one poll for all subscribers of the stop, channels are closed after unsubscribing
+	cancel()
+	for range first {
+	}
+	for range other {
+	}
+	requests := atomic.LoadInt32(&api.requests)
+	assert.Less(t, requests, int32(15))
+
+	time.Sleep(50 * time.Millisecond)
+	assert.LessOrEqual(t, atomic.LoadInt32(&api.requests), requests+1, "polling stops without subscribers")
+}
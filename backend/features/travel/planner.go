@@ -11,10 +11,53 @@ import (
 	"stop-checker.com/features/travel/algorithms"
 )
 
+// PlannerOptions restrict the travel plans and schedules
+type PlannerOptions struct {
+	Modes      []model.RouteMode // route modes to travel by, every mode when empty
+	Accessible bool              // only use stops, trips and pathways that are wheelchair accessible
+	Realtime   bool              // use predicted times and skip canceled trips in the near-term
+}
+
+// stops without wheelchair boarding information are not used by accessible plans
+func (o PlannerOptions) uses(stop model.Stop) bool {
+	return !o.Accessible || stop.Wheelchair == model.Accessible
+}
+
+// trips that can be used, nil when every trip can be used
+func (o PlannerOptions) trips() model.TripFilter {
+	if !o.Accessible {
+		return nil
+	}
+	return func(trip model.Trip) bool {
+		return trip.Wheelchair == model.Accessible
+	}
+}
+
+func (o PlannerOptions) allows(route model.Route) bool {
+	if len(o.Modes) == 0 {
+		return true
+	}
+
+	for _, mode := range o.Modes {
+		if route.Mode() == mode {
+			return true
+		}
+	}
+	return false
+}
+
 type Planner struct {
 	stopLocationIndex repository.StopLocationSearch
 	stopRouteIndex    repository.StopRoutes
+	routes            repository.Routes
+	trips             repository.Trips
+	stops             repository.Stops
+	stopsByParent     repository.InvertedIndex[model.Stop]
+	transfers         repository.Transfers
+	pathways          repository.Pathways
 	reachIndex        repository.ReachableWithSchedule
+	stopTimesByTrip   repository.InvertedIndex[model.StopTime]
+	realtime          *realtime
 	directionsCache   walkingDirectionsCache
 	directions        walkingDirections
 	metrics           PlannerMetrics
@@ -23,7 +66,15 @@ type Planner struct {
 func NewPlanner(
 	stopLocationIndex repository.StopLocationSearch,
 	stopRouteIndex repository.StopRoutes,
+	routes repository.Routes,
+	trips repository.Trips,
+	stops repository.Stops,
+	stopsByParent repository.InvertedIndex[model.Stop],
+	transfers repository.Transfers,
+	pathways repository.Pathways,
 	reachIndex repository.ReachableWithSchedule,
+	stopTimesByTrip repository.InvertedIndex[model.StopTime],
+	predictions realtimePredictions,
 	directionsCache walkingDirectionsCache,
 	directions walkingDirections,
 	metrics PlannerMetrics,
@@ -31,23 +82,31 @@ func NewPlanner(
 	return &Planner{
 		stopLocationIndex: stopLocationIndex,
 		stopRouteIndex:    stopRouteIndex,
+		routes:            routes,
+		trips:             trips,
+		stops:             stops,
+		stopsByParent:     stopsByParent,
+		transfers:         transfers,
+		pathways:          pathways,
 		reachIndex:        reachIndex,
+		stopTimesByTrip:   stopTimesByTrip,
+		realtime:          newRealtime(predictions, stopTimesByTrip),
 		directionsCache:   directionsCache,
 		directions:        directions,
 		metrics:           metrics,
 	}
 }
 
-func (p *Planner) Arrive(by time.Time, origin, destination model.Location) (*model.TravelPlan, error) {
-	solution, err := p.explore(by, destination, origin, ARRIVE_BY)
+func (p *Planner) Arrive(by time.Time, origin, destination model.Location, options PlannerOptions) (*model.TravelPlan, error) {
+	solution, err := p.explore(by, destination, origin, ARRIVE_BY, options)
 	if err != nil {
 		return nil, err
 	}
 	return p.arriveTravelPlan(solution, origin, destination), nil
 }
 
-func (p *Planner) Depart(at time.Time, origin, destination model.Location) (*model.TravelPlan, error) {
-	solution, err := p.explore(at, origin, destination, DEPART_AT)
+func (p *Planner) Depart(at time.Time, origin, destination model.Location, options PlannerOptions) (*model.TravelPlan, error) {
+	solution, err := p.explore(at, origin, destination, DEPART_AT, options)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +159,7 @@ func (p *Planner) arriveTravelPlan(solution *node, origin, destination model.Loc
 	return plan
 }
 
-func (p *Planner) explore(t time.Time, initial, target model.Location, mode Mode) (*node, error) {
+func (p *Planner) explore(t time.Time, initial, target model.Location, mode Mode, options PlannerOptions) (*node, error) {
 	// initial node
 	initialNode := createInitialNode(t, initial)
 
@@ -112,7 +171,7 @@ func (p *Planner) explore(t time.Time, initial, target model.Location, mode Mode
 	pq := algorithms.NewPriorityQueue(func(a, b *node) bool {
 		return a.Weight(target, t, mode) < b.Weight(target, t, mode)
 	})
-	pq.Push(p.exploreInitial(initialNode, mode)...)
+	pq.Push(p.exploreInitial(initialNode, mode, options)...)
 
 	// visited
 	explored := algorithms.Set{}
@@ -134,10 +193,10 @@ func (p *Planner) explore(t time.Time, initial, target model.Location, mode Mode
 		}
 
 		// explore nodes by walking
-		pq.Push(p.exploreWalking(current, mode)...)
+		pq.Push(p.exploreWalking(current, mode, options)...)
 
 		// explore nodes by transit
-		pq.Push(p.exploreTransit(current, mode)...)
+		pq.Push(p.exploreTransit(current, mode, options)...)
 
 		distance := current.Distance(target)
 
@@ -158,7 +217,7 @@ func (p *Planner) explore(t time.Time, initial, target model.Location, mode Mode
 	return nil, errors.New("no solution")
 }
 
-func (p *Planner) exploreWalking(current *node, mode Mode) []*node {
+func (p *Planner) exploreWalking(current *node, mode Mode, options PlannerOptions) []*node {
 	nodes := []*node{}
 
 	// don't walk two nodes in a row
@@ -166,9 +225,49 @@ func (p *Planner) exploreWalking(current *node, mode Mode) []*node {
 		return nodes
 	}
 
+	// platforms in the same station are always reachable no matter how large the station is
+	platforms := algorithms.Set{}
+	for _, platform := range p.stationPlatforms(current) {
+		platforms.Add(platform.ID())
+		if !options.uses(platform) {
+			continue
+		}
+
+		// walking time using the station's pathways
+		var duration time.Duration
+		var err error
+		if mode == DEPART_AT {
+			duration, err = p.pathways.Duration(current.ID(), platform.ID(), options.Accessible)
+		} else {
+			duration, err = p.pathways.Duration(platform.ID(), current.ID(), options.Accessible)
+		}
+		if errors.Is(err, model.ErrInaccessiblePathway) {
+			continue
+		}
+		if err != nil {
+			duration = STATION_TRANSFER
+		}
+
+		arrival := current.time.Add(duration)
+		if mode == ARRIVE_BY {
+			arrival = current.time.Add(-duration)
+		}
+
+		nodes = append(nodes, createWalkingNode(current, &walkingNodeParams{
+			id:       platform.ID(),
+			location: platform.Location,
+			arrival:  arrival,
+			distance: 0,
+		}))
+	}
+
 	for _, neighbor := range p.stopLocationIndex.Query(current.Location, MAX_WALK) {
-		// don't walk to the same stop
-		if neighbor.ID() == current.ID() {
+		// don't walk to the same stop or platforms that were already added
+		if neighbor.ID() == current.ID() || platforms.Contains(neighbor.ID()) {
+			continue
+		}
+
+		if !options.uses(neighbor.Stop) {
 			continue
 		}
 
@@ -193,6 +292,23 @@ func (p *Planner) exploreWalking(current *node, mode Mode) []*node {
 	return nodes
 }
 
+// other platforms in the station of the current stop
+func (p *Planner) stationPlatforms(current *node) []model.Stop {
+	stop, err := p.stops.Get(current.ID())
+	if err != nil || stop.Parent == "" {
+		return nil
+	}
+
+	siblings, _ := p.stopsByParent.Get(stop.Parent)
+	platforms := []model.Stop{}
+	for _, sibling := range siblings {
+		if sibling.ID() != stop.ID() {
+			platforms = append(platforms, sibling)
+		}
+	}
+	return platforms
+}
+
 func (p *Planner) getWalkingDirections(current *node, neighbor model.StopWithDistance) model.Path {
 	directions, err := p.directionsCache.GetDirections(current.ID(), neighbor.ID())
 
@@ -213,9 +329,10 @@ func (p *Planner) getWalkingDirections(current *node, neighbor model.StopWithDis
 	return directions
 }
 
-func (p *Planner) exploreTransit(current *node, mode Mode) []*node {
+func (p *Planner) exploreTransit(current *node, mode Mode, options PlannerOptions) []*node {
 	blockers := algorithms.Set{}
 	fastest := map[string]fastestTransit{} // fastest transit {stopid: fastest}
+	previous := current.lastTransit()
 
 	for _, stopRoute := range p.stopRouteIndex.Get(current.ID()) {
 		// ignore blocked stop routes by current node
@@ -224,8 +341,22 @@ func (p *Planner) exploreTransit(current *node, mode Mode) []*node {
 		}
 		blockers.Add(stopRoute.DirectedID())
 
+		// ignore routes with modes that are not allowed
+		if route, err := p.routes.Get(stopRoute.RouteId); err == nil && !options.allows(route) {
+			continue
+		}
+
+		// transfer rules from the previous transit leg
+		t, allowed := p.transferTime(current, previous, stopRoute.RouteId, mode)
+		if !allowed {
+			continue
+		}
+
+		reachable := p.exploreTransitRoute(current, stopRoute, mode, t, options)
+		reachable = append(reachable, p.exploreInSeat(current, previous, stopRoute, mode, options)...)
+
 		// reachable stops
-		for _, reachable := range p.exploreTransitRoute(current, stopRoute, mode) {
+		for _, reachable := range reachable {
 			current, seen := fastest[reachable.stopId]
 			if !seen || !current.Faster(reachable.stopArrival, mode) {
 				fastest[reachable.stopId] = reachable
@@ -236,14 +367,111 @@ func (p *Planner) exploreTransit(current *node, mode Mode) []*node {
 	return p.getTransitNodes(current, fastest, blockers)
 }
 
-func (p *Planner) exploreTransitRoute(current *node, stopRoute model.StopRoute, mode Mode) []fastestTransit {
+func (p *Planner) exploreTransitRoute(current *node, stopRoute model.StopRoute, mode Mode, t time.Time, options PlannerOptions) []fastestTransit {
+	return toFastestTransit(p.reachTransitRoute(current, stopRoute, mode, t, options), mode)
+}
+
+func (p *Planner) reachTransitRoute(current *node, stopRoute model.StopRoute, mode Mode, t time.Time, options PlannerOptions) []model.ReachableSchedule {
 	var results []model.ReachableSchedule
+	if options.Realtime && p.realtime.near(t) {
+		results = p.reachRealtime(current, stopRoute, mode, t, options.trips())
+	} else {
+		results = p.reach(current, stopRoute, mode, t, options.trips())
+	}
+
+	return usable(results, mode, options)
+}
+
+// only get off at stops that can be used
+func usable(results []model.ReachableSchedule, mode Mode, options PlannerOptions) []model.ReachableSchedule {
+	if !options.Accessible {
+		return results
+	}
+
+	usable := []model.ReachableSchedule{}
+	for _, result := range results {
+		if (mode == DEPART_AT && options.uses(result.Destination)) || (mode == ARRIVE_BY && options.uses(result.Origin)) {
+			usable = append(usable, result)
+		}
+	}
+	return usable
+}
+
+func (p *Planner) reach(current *node, stopRoute model.StopRoute, mode Mode, t time.Time, trips model.TripFilter) []model.ReachableSchedule {
 	if mode == DEPART_AT {
-		results = p.reachIndex.ReachableForwardWithNext(current.ID(), stopRoute.RouteId, current.time)
+		return p.reachIndex.ReachableForwardWithNext(current.ID(), stopRoute.RouteId, t, trips)
+	}
+	return p.reachIndex.ReachableBackwardWithPrevious(current.ID(), stopRoute.RouteId, t, trips)
+}
+
+/* reachRealtime
+reach using the predicted times of trips. the next trips after t that can still be taken are used
+like the schedule, then delayed trips scheduled up to REALTIME_LOOKBACK earlier are added when they
+can be taken. canceled trips, trips skipping the stop and trips leaving early are not used
+*/
+func (p *Planner) reachRealtime(current *node, stopRoute model.StopRoute, mode Mode, t time.Time, trips model.TripFilter) []model.ReachableSchedule {
+	search := p.realtime.search()
+
+	// the trip can be taken from the current stop. delayed trips must have a prediction
+	catchable := func(trip model.Trip, delayed bool) bool {
+		if trips != nil && !trips(trip) {
+			return false
+		}
+
+		prediction, ok := search.at(trip.Id, current.ID())
+		switch {
+		case ok && prediction.Skipped:
+			return false
+		case !ok || !prediction.Predicted:
+			return !delayed
+		case mode == DEPART_AT:
+			return !prediction.Departure.Before(t)
+		default:
+			return !prediction.Arrival.After(t)
+		}
+	}
+	scheduled := func(trip model.Trip) bool { return catchable(trip, false) }
+	delayed := func(trip model.Trip) bool { return catchable(trip, true) }
+
+	var results []model.ReachableSchedule
+	if mode == DEPART_AT {
+		results = p.reachIndex.ReachableForwardWithNext(current.ID(), stopRoute.RouteId, t, scheduled)
+		results = append(results, p.reachIndex.ReachableForwardWithin(current.ID(), stopRoute.RouteId, t.Add(-REALTIME_LOOKBACK), t, delayed)...)
 	} else {
-		results = p.reachIndex.ReachableBackwardWithPrevious(current.ID(), stopRoute.RouteId, current.time)
+		results = p.reachIndex.ReachableBackwardWithPrevious(current.ID(), stopRoute.RouteId, t, scheduled)
+		results = append(results, p.reachIndex.ReachableBackwardWithin(current.ID(), stopRoute.RouteId, t.Add(REALTIME_LOOKBACK), t, delayed)...)
 	}
 
+	best := map[string]model.ReachableSchedule{} // best result by the reached stop
+	for _, result := range results {
+		leg := search.predict(result.Trip.Id, result.Origin.Id, result.Destination.Id, result.Departure, result.Arrival)
+		if !leg.board || !leg.alight {
+			continue
+		}
+		result.Departure = leg.departure
+		result.Arrival = leg.arrival
+
+		if mode == DEPART_AT {
+			previous, ok := best[result.Destination.Id]
+			if !result.Departure.Before(t) && (!ok || result.Arrival.Before(previous.Arrival)) {
+				best[result.Destination.Id] = result
+			}
+		} else {
+			previous, ok := best[result.Origin.Id]
+			if !result.Arrival.After(t) && (!ok || result.Departure.After(previous.Departure)) {
+				best[result.Origin.Id] = result
+			}
+		}
+	}
+
+	reachable := []model.ReachableSchedule{}
+	for _, result := range best {
+		reachable = append(reachable, result)
+	}
+	return reachable
+}
+
+func toFastestTransit(results []model.ReachableSchedule, mode Mode) []fastestTransit {
 	reachable := make([]fastestTransit, len(results))
 
 	for i, result := range results {
@@ -281,13 +509,19 @@ func (p *Planner) getTransitNodes(current *node, fastest map[string]fastestTrans
 				routeId: f.routeId,
 			},
 			blockers: blockers,
+			inSeat:   f.inSeat,
 		}))
 	}
 	return nodes
 }
 
-func (p *Planner) exploreInitial(initial *node, mode Mode) []*node {
-	neighbors := p.stopLocationIndex.Query(initial.Location, MAX_WALK_INITIAL)
+func (p *Planner) exploreInitial(initial *node, mode Mode, options PlannerOptions) []*node {
+	neighbors := []model.StopWithDistance{}
+	for _, neighbor := range p.stopLocationIndex.Query(initial.Location, MAX_WALK_INITIAL) {
+		if options.uses(neighbor.Stop) {
+			neighbors = append(neighbors, neighbor)
+		}
+	}
 	nodes := make([]*node, len(neighbors))
 	wg := sync.WaitGroup{}
 
@@ -13,6 +13,21 @@ const MAX_WALK_TARGET = 1000.0
 
 const TRANSFER_PENALTY = 5 * time.Minute
 
+// time to walk between platforms of the same station. not counted as walking distance
+const STATION_TRANSFER = 2 * time.Minute
+
+// realtime predictions are used for trips within the horizon of now
+const REALTIME_HORIZON = 90 * time.Minute
+
+// delayed trips scheduled this long before the requested time may still be caught
+const REALTIME_LOOKBACK = 15 * time.Minute
+
+// trips considered by the scheduler for each realtime transit leg
+const REALTIME_CANDIDATES = 8
+
+// trips tried by the scheduler for a transit leg when the transfer rules of the first trips don't allow them
+const TRANSFER_CANDIDATES = 8
+
 // value multiplied by distance remaining to the stop (2.5 minute penalty per km away)
 // typical A* heuristic
 const DISTANCE_PENALTY = (2*time.Minute + 30*time.Second) / 1000
@@ -12,6 +12,7 @@ type fastestTransit struct {
 	stopId       string
 	stopArrival  time.Time
 	stopLocation model.Location
+	inSeat       bool // continues on the same vehicle as the previous trip
 }
 
 func (f *fastestTransit) Faster(t time.Time, mode Mode) bool {
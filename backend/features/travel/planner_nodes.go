@@ -46,6 +46,17 @@ func (n *node) Transfers() int {
 	return 0
 }
 
+// node reached by the latest transit leg when it is this node or this node was walked to from it
+func (n *node) lastTransit() *node {
+	if n.transit != nil {
+		return n
+	}
+	if n.prev != nil && n.prev.transit != nil {
+		return n.prev
+	}
+	return nil
+}
+
 func (n *node) Blocked(directedRouteId string) bool {
 	return n.blockers.Contains(directedRouteId)
 }
@@ -79,6 +90,7 @@ type transitNodeParams struct {
 	arrival  time.Time // the time we arrive at this node
 	transit  *transit
 	blockers algorithms.Set
+	inSeat   bool // staying on the vehicle is not a transfer
 }
 
 type walkingNodeParams struct {
@@ -149,6 +161,11 @@ func createWalkingNode(prev *node, params *walkingNodeParams) *node {
 }
 
 func createTransitNode(prev *node, params *transitNodeParams) *node {
+	transfers := prev.transfers + 1 // increase the number of transfers
+	if params.inSeat {
+		transfers = prev.transfers
+	}
+
 	return &node{
 		prev:          prev,
 		id:            params.id,
@@ -157,7 +174,7 @@ func createTransitNode(prev *node, params *transitNodeParams) *node {
 		blockers:      params.blockers,
 		transit:       params.transit,
 		Location:      params.location,
-		transfers:     prev.transfers + 1, // increase the number of transfers
+		transfers:     transfers,
 		walking:       prev.walking,
 		weight:        0, // updated separately
 		computeWeight: true,
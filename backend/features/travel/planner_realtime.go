@@ -0,0 +1,130 @@
+package travel
+
+import (
+	"time"
+
+	"stop-checker.com/db/model"
+	"stop-checker.com/db/repository"
+)
+
+// predicted times of trips, implemented by the GTFS-Realtime trip updates
+type realtimePredictions interface {
+	Predict(scheduled []model.StopTime) ([]model.Prediction, bool)
+}
+
+/* realtime
+overlays the predicted times of trips onto the schedule. predictions are only used for
+times within REALTIME_HORIZON of now, the schedule is used further out
+*/
+type realtime struct {
+	predictions     realtimePredictions
+	stopTimesByTrip repository.InvertedIndex[model.StopTime]
+}
+
+// nil when there are no predictions
+func newRealtime(predictions realtimePredictions, stopTimesByTrip repository.InvertedIndex[model.StopTime]) *realtime {
+	if predictions == nil {
+		return nil
+	}
+	return &realtime{
+		predictions:     predictions,
+		stopTimesByTrip: stopTimesByTrip,
+	}
+}
+
+// predictions are used for the time
+func (r *realtime) near(t time.Time) bool {
+	if r == nil {
+		return false
+	}
+	now := time.Now()
+	return t.After(now.Add(-REALTIME_HORIZON)) && t.Before(now.Add(REALTIME_HORIZON))
+}
+
+// predictions of the trips used by one search so each trip is only predicted once
+type realtimeSearch struct {
+	*realtime
+	trips map[string][]model.Prediction // nil predictions when the trip has none
+}
+
+func (r *realtime) search() *realtimeSearch {
+	return &realtimeSearch{realtime: r, trips: map[string][]model.Prediction{}}
+}
+
+// predictions of each stop time of a trip, false when the trip has no predictions
+func (s *realtimeSearch) trip(tripId string) ([]model.Prediction, bool) {
+	predictions, seen := s.trips[tripId]
+	if !seen {
+		stopTimes, _ := s.stopTimesByTrip.Get(tripId)
+		predictions, _ = s.predictions.Predict(stopTimes)
+		s.trips[tripId] = predictions
+	}
+	return predictions, predictions != nil
+}
+
+// prediction of a trip at a stop, false when the trip has no predictions or doesn't visit the stop
+func (s *realtimeSearch) at(tripId, stopId string) (model.Prediction, bool) {
+	predictions, ok := s.trip(tripId)
+	if !ok {
+		return model.Prediction{}, false
+	}
+
+	for _, prediction := range predictions {
+		if prediction.StopTime.StopId == stopId {
+			return prediction, true
+		}
+	}
+	return model.Prediction{}, false
+}
+
+type realtimeLeg struct {
+	departure time.Time // departure from the origin
+	arrival   time.Time // arrival at the destination
+	board     bool      // the trip stops at the origin
+	alight    bool      // the trip stops at the destination
+}
+
+/* predict
+the departure and arrival of a trip between two stops given the scheduled times. the scheduled
+times are kept when the trip has no predictions or the predictions are for another service day
+*/
+func (s *realtimeSearch) predict(tripId, originId, destinationId string, departure, arrival time.Time) realtimeLeg {
+	leg := realtimeLeg{departure: departure, arrival: arrival, board: true, alight: true}
+
+	predictions, ok := s.trip(tripId)
+	if !ok {
+		return leg
+	}
+
+	origin, destination := -1, -1
+	for i, prediction := range predictions {
+		if origin == -1 && prediction.StopTime.StopId == originId {
+			origin = i
+		} else if origin != -1 && prediction.StopTime.StopId == destinationId {
+			destination = i
+			break
+		}
+	}
+	if origin == -1 || destination == -1 {
+		return leg
+	}
+
+	// canceled trips and skipped stops are only for the trip of the predictions' service day
+	originPrediction, destinationPrediction := predictions[origin], predictions[destination]
+	if !originPrediction.StopTime.Departure.OnServiceDay(originPrediction.Date).Equal(departure) {
+		return leg
+	}
+
+	leg.board = !originPrediction.Skipped
+	leg.alight = !destinationPrediction.Skipped
+
+	if originPrediction.Predicted {
+		leg.departure = originPrediction.Departure
+	}
+	if destinationPrediction.Predicted {
+		leg.arrival = destinationPrediction.Arrival
+	} else {
+		leg.arrival = arrival.Add(leg.departure.Sub(departure))
+	}
+	return leg
+}
@@ -0,0 +1,227 @@
+package travel
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+	"stop-checker.com/db/repository"
+)
+
+type stopTimesByTrip map[string][]model.StopTime
+
+func (s stopTimesByTrip) Get(tripId string) ([]model.StopTime, error) {
+	stopTimes, ok := s[tripId]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return stopTimes, nil
+}
+
+// predictions with a fixed delay and skipped stops for each trip
+type testPredictions struct {
+	date    time.Time
+	delay   map[string]time.Duration
+	skipped map[string]bool // {tripId:stopId: skipped}
+	calls   map[string]int  // {tripId: number of predictions}
+}
+
+func (t *testPredictions) Predict(scheduled []model.StopTime) ([]model.Prediction, bool) {
+	if len(scheduled) == 0 {
+		return nil, false
+	}
+	if t.calls != nil {
+		t.calls[scheduled[0].TripId]++
+	}
+	delay, ok := t.delay[scheduled[0].TripId]
+	if !ok {
+		return nil, false
+	}
+
+	predictions := []model.Prediction{}
+	for _, stopTime := range scheduled {
+		predictions = append(predictions, model.Prediction{
+			StopTime:  stopTime,
+			Date:      t.date,
+			Arrival:   t.date.Add(model.TimeDiff(0, stopTime.Arrival) + delay),
+			Departure: t.date.Add(model.TimeDiff(0, stopTime.Departure) + delay),
+			Delay:     delay,
+			Predicted: true,
+			Skipped:   t.skipped[stopTime.TripId+":"+stopTime.StopId],
+		})
+	}
+	return predictions, true
+}
+
+func TestRealtimePredict(t *testing.T) {
+	date := time.Date(2022, 9, 12, 0, 0, 0, 0, time.UTC)
+	trip := func(tripId string) []model.StopTime {
+		return []model.StopTime{
+			{TripId: tripId, StopId: "A", Arrival: model.NewTime(8, 0, 0), Departure: model.NewTime(8, 0, 0)},
+			{TripId: tripId, StopId: "B", Arrival: model.NewTime(8, 10, 0), Departure: model.NewTime(8, 10, 0)},
+		}
+	}
+
+	realtime := newRealtime(&testPredictions{
+		date:    date,
+		delay:   map[string]time.Duration{"T1": 5 * time.Minute, "T2": 0},
+		skipped: map[string]bool{"T2:B": true},
+	}, stopTimesByTrip{"T1": trip("T1"), "T2": trip("T2"), "T3": trip("T3")}).search()
+
+	departure := model.NewTime(8, 0, 0).On(date)
+	arrival := model.NewTime(8, 10, 0).On(date)
+
+	// delays move both times
+	leg := realtime.predict("T1", "A", "B", departure, arrival)
+	assert.Equal(t, departure.Add(5*time.Minute), leg.departure)
+	assert.Equal(t, arrival.Add(5*time.Minute), leg.arrival)
+	assert.True(t, leg.board && leg.alight)
+
+	// skipped stops can't be used on the day of the predictions
+	leg = realtime.predict("T2", "A", "B", departure, arrival)
+	assert.True(t, leg.board)
+	assert.False(t, leg.alight)
+	leg = realtime.predict("T2", "A", "B", departure.AddDate(0, 0, 1), arrival.AddDate(0, 0, 1))
+	assert.True(t, leg.board && leg.alight)
+
+	// the schedule is used without predictions or when they are for another day
+	leg = realtime.predict("T3", "A", "B", departure, arrival)
+	assert.Equal(t, departure, leg.departure)
+	leg = realtime.predict("T1", "A", "B", departure.AddDate(0, 0, 1), arrival.AddDate(0, 0, 1))
+	assert.Equal(t, departure.AddDate(0, 0, 1), leg.departure)
+
+	assert.Nil(t, newRealtime(nil, stopTimesByTrip{}))
+	assert.False(t, newRealtime(nil, stopTimesByTrip{}).near(time.Now()))
+}
+
+// trips from A to B in 10 minutes
+type testReach struct {
+	departures map[string]time.Time
+	order      []string
+}
+
+func (r *testReach) forward(after, before time.Time, filter model.TripFilter) []model.ReachableSchedule {
+	for _, tripId := range r.order {
+		departure := r.departures[tripId]
+		if !departure.After(after) || (!before.IsZero() && !departure.Before(before)) || !filter(model.Trip{Id: tripId}) {
+			continue
+		}
+		return []model.ReachableSchedule{{
+			Departure:   departure,
+			Arrival:     departure.Add(10 * time.Minute),
+			Origin:      model.Stop{Id: "A"},
+			Destination: model.Stop{Id: "B"},
+			Trip:        model.Trip{Id: tripId},
+		}}
+	}
+	return nil
+}
+
+func (r *testReach) ReachableForwardWithNext(originId, routeId string, after time.Time, filter model.TripFilter) []model.ReachableSchedule {
+	return r.forward(after, time.Time{}, filter)
+}
+
+func (r *testReach) ReachableForwardWithin(originId, routeId string, after, before time.Time, filter model.TripFilter) []model.ReachableSchedule {
+	return r.forward(after, before, filter)
+}
+
+func (r *testReach) ReachableBackwardWithPrevious(originId, routeId string, before time.Time, filter model.TripFilter) []model.ReachableSchedule {
+	return nil
+}
+
+func (r *testReach) ReachableBackwardWithin(originId, routeId string, before, after time.Time, filter model.TripFilter) []model.ReachableSchedule {
+	return nil
+}
+
+func TestReachRealtimeFrequentRoute(t *testing.T) {
+	// a trip every 2 minutes from 40 minutes ago
+	base := time.Now().UTC().Truncate(time.Minute).Add(-40 * time.Minute)
+	date := time.Date(base.Year(), base.Month(), base.Day(), 0, 0, 0, 0, time.UTC)
+
+	reach := &testReach{departures: map[string]time.Time{}}
+	stopTimes := stopTimesByTrip{}
+	delays := map[string]time.Duration{}
+
+	for i := 0; i < 60; i++ {
+		tripId := fmt.Sprintf("T%d", i)
+		departure := base.Add(time.Duration(i) * 2 * time.Minute)
+		scheduled := model.Time(departure.Sub(date) / time.Second)
+
+		reach.order = append(reach.order, tripId)
+		reach.departures[tripId] = departure
+		stopTimes[tripId] = []model.StopTime{
+			{TripId: tripId, StopId: "A", Arrival: scheduled, Departure: scheduled},
+			{TripId: tripId, StopId: "B", Arrival: scheduled + 600, Departure: scheduled + 600},
+		}
+		delays[tripId] = 0
+	}
+
+	predictions := &testPredictions{date: date, delay: delays, skipped: map[string]bool{"T21:A": true}}
+	planner := &Planner{reachIndex: reach, realtime: newRealtime(predictions, stopTimes)}
+	options := PlannerOptions{Realtime: true}
+	at := base.Add(40*time.Minute + 30*time.Second)
+
+	// every earlier trip left on time and the next trip skips the stop
+	predictions.calls = map[string]int{}
+	results := planner.reachTransitRoute(&node{id: "A"}, model.StopRoute{RouteId: "1"}, DEPART_AT, at, options)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "T22", results[0].Trip.Id)
+
+	// each trip is only predicted once by the search
+	assert.NotEmpty(t, predictions.calls)
+	for tripId, calls := range predictions.calls {
+		assert.Equal(t, 1, calls, tripId)
+	}
+
+	// a delayed trip that can still be caught
+	delays["T16"] = 10 * time.Minute
+	results = planner.reachTransitRoute(&node{id: "A"}, model.StopRoute{RouteId: "1"}, DEPART_AT, at, options)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "T16", results[0].Trip.Id)
+	assert.Equal(t, base.Add(42*time.Minute), results[0].Departure)
+
+	// the scheduler takes the same trip
+	reachImpl := &scheduleReachImpl{
+		reachIndex:      &testSchedule{testReach: reach, stopTimes: stopTimes},
+		stopTimesByTrip: stopTimes,
+		realtime:        planner.realtime,
+	}
+	result, err := reachImpl.Depart("A", "B", "1", at, nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "T16", result.tripId)
+
+	delays["T16"] = 0
+	result, err = reachImpl.Depart("A", "B", "1", at, nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "T22", result.tripId)
+}
+
+// origin stop times of the trips from testReach
+type testSchedule struct {
+	*testReach
+	stopTimes stopTimesByTrip
+}
+
+func (s *testSchedule) ReachableBetweenWithSchedule(originId, destinationId, routeId string, filter model.TripFilter) (repository.Schedule, repository.Schedule) {
+	return s, s
+}
+
+func (s *testSchedule) After(after time.Time, limit int) []model.ScheduleResult {
+	results := []model.ScheduleResult{}
+	for _, tripId := range s.order {
+		if departure := s.departures[tripId]; departure.After(after) && len(results) < limit {
+			results = append(results, model.ScheduleResult{StopTime: s.stopTimes[tripId][0], Time: departure})
+		}
+	}
+	return results
+}
+
+func (s *testSchedule) Next(t time.Time) (model.ScheduleResult, error) { return s.After(t, 1)[0], nil }
+func (s *testSchedule) Previous(t time.Time) (model.ScheduleResult, error) {
+	return model.ScheduleResult{}, nil
+}
+func (s *testSchedule) Before(t time.Time, limit int) []model.ScheduleResult { return nil }
+func (s *testSchedule) Day(on time.Time) []model.ScheduleResult              { return nil }
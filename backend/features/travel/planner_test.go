@@ -1,9 +1,11 @@
 package travel
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"stop-checker.com/db"
 	"stop-checker.com/db/model"
 	"stop-checker.com/features/osrm"
@@ -14,8 +16,169 @@ func newTestPlanner() *Planner {
 	cacheData, _ := osrm.ReadCacheData("../../../data/300m-directions.json")
 	cache := osrm.NewCache(cacheData)
 	client := osrm.NewClient("http://localhost:5000")
-	return NewPlanner(database.StopLocationIndex, database.StopRouteIndex, database.ReachIndex, cache, client, &PlannerMetricsEmpty{})
+	return NewPlanner(database.StopLocationIndex, database.StopRouteIndex, database.Routes, database.Trips, database.Stops, database.StopsByParent, database.Transfers, database.Pathways, database.ReachIndex, database.StopTimesByTrip, nil, cache, client, &PlannerMetricsEmpty{})
 }
+
+// walking directions in a straight line
+type testDirections struct{}
+
+func (testDirections) GetDirections(origin, destination model.Location) (model.Path, error) {
+	return model.Path{Distance: model.Distance(origin, destination), Path: []model.Location{origin, destination}}, nil
+}
+
+// walking directions between stops are never cached
+type testDirectionsCache struct{}
+
+func (testDirectionsCache) GetDirections(originId, destinationId string) (model.Path, error) {
+	return model.Path{}, errors.New("not cached")
+}
+
+// the day the test trips run on
+var testDay = time.Date(2022, 9, 12, 0, 0, 0, 0, time.Local)
+
+// dataset with stops 5km apart so they can't be walked between. services run every day of 2022
+func testDataset(stopIds ...string) *model.Dataset {
+	dataset := &model.Dataset{
+		Services: []model.Service{{
+			Id:    "S",
+			On:    [7]bool{true, true, true, true, true, true, true},
+			Start: time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local),
+			End:   time.Date(2022, 12, 31, 0, 0, 0, 0, time.Local),
+		}},
+	}
+
+	for i, stopId := range stopIds {
+		dataset.Stops = append(dataset.Stops, model.Stop{
+			Id:         stopId,
+			Location:   model.Location{Latitude: 45 + 0.05*float64(i), Longitude: -75.7},
+			Wheelchair: model.Accessible,
+		})
+	}
+	return dataset
+}
+
+// stop time arriving and departing at a time
+func stopAt(stopId string, hour, minute int) model.StopTime {
+	return model.StopTime{StopId: stopId, Arrival: model.NewTime(hour, minute, 0), Departure: model.NewTime(hour, minute, 0)}
+}
+
+// add a trip of the service "S" and its route unless the route was already added
+func addTestTrip(dataset *model.Dataset, trip model.Trip, stopTimes ...model.StopTime) {
+	trip.ServiceId = "S"
+	dataset.Trips = append(dataset.Trips, trip)
+
+	for i, stopTime := range stopTimes {
+		stopTime.TripId = trip.Id
+		stopTime.Sequence = i + 1
+		dataset.StopTimes = append(dataset.StopTimes, stopTime)
+	}
+
+	for _, route := range dataset.Routes {
+		if route.Id == trip.RouteId {
+			return
+		}
+	}
+	dataset.Routes = append(dataset.Routes, model.Route{Id: trip.RouteId})
+}
+
+func newTestTravel(dataset *model.Dataset) (*Planner, *Scheduler) {
+	database := db.NewDB(dataset)
+	planner := NewPlanner(database.StopLocationIndex, database.StopRouteIndex, database.Routes, database.Trips, database.Stops, database.StopsByParent, database.Transfers, database.Pathways, database.ReachIndex, database.StopTimesByTrip, nil, testDirectionsCache{}, testDirections{}, &PlannerMetricsEmpty{})
+	scheduler := NewScheduler(testDirections{}, testDirectionsCache{}, database.Stops, database.Transfers, database.Pathways, database.ReachIndex, database.StopTimesByTrip, nil)
+	return planner, scheduler
+}
+
+// trips of the transit legs of a schedule
+func scheduledTrips(schedule *model.TravelSchedule) []string {
+	trips := []string{}
+	for _, leg := range schedule.Legs {
+		if leg.Transit != nil {
+			trips = append(trips, leg.Transit.TripId)
+		}
+	}
+	return trips
+}
+
+func TestPlannerModes(t *testing.T) {
+	// the train is faster than the bus
+	dataset := testDataset("A", "B")
+	dataset.Routes = []model.Route{{Id: "train", Type: 2}, {Id: "bus", Type: 3}}
+	addTestTrip(dataset, model.Trip{Id: "T1", RouteId: "train"}, stopAt("A", 8, 0), stopAt("B", 8, 10))
+	addTestTrip(dataset, model.Trip{Id: "B1", RouteId: "bus"}, stopAt("A", 8, 0), stopAt("B", 8, 30))
+
+	planner, _ := newTestTravel(dataset)
+	a, b := dataset.Stops[0].Location, dataset.Stops[1].Location
+	at := testDay.Add(7*time.Hour + 55*time.Minute)
+
+	solution, err := planner.explore(at, a, b, DEPART_AT, PlannerOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "T1", solution.prev.transit.tripId)
+
+	solution, err = planner.explore(at, a, b, DEPART_AT, PlannerOptions{Modes: []model.RouteMode{model.RouteModeBus}})
+	assert.NoError(t, err)
+	assert.Equal(t, "B1", solution.prev.transit.tripId)
+
+	_, err = planner.explore(at, a, b, DEPART_AT, PlannerOptions{Modes: []model.RouteMode{model.RouteModeSubway}})
+	assert.Error(t, err)
+}
+
+func TestPlannerAccessible(t *testing.T) {
+	accessible := PlannerOptions{Accessible: true}
+
+	// the quickest trip isn't accessible and the second quickest transfers at an inaccessible stop
+	dataset := testDataset("A", "B", "C")
+	dataset.Stops[1].Wheelchair = model.NotAccessible
+	addTestTrip(dataset, model.Trip{Id: "T1", RouteId: "1", Wheelchair: model.NotAccessible}, stopAt("A", 8, 0), stopAt("C", 8, 10))
+	addTestTrip(dataset, model.Trip{Id: "T2", RouteId: "2", Wheelchair: model.Accessible}, stopAt("A", 8, 0), stopAt("B", 8, 5))
+	addTestTrip(dataset, model.Trip{Id: "U2", RouteId: "3", Wheelchair: model.Accessible}, stopAt("B", 8, 6), stopAt("C", 8, 12))
+	addTestTrip(dataset, model.Trip{Id: "T3", RouteId: "4", Wheelchair: model.Accessible}, stopAt("A", 8, 0), stopAt("C", 8, 30))
+
+	planner, _ := newTestTravel(dataset)
+	a, c := dataset.Stops[0].Location, dataset.Stops[2].Location
+	at := testDay.Add(7*time.Hour + 55*time.Minute)
+
+	solution, err := planner.explore(at, a, c, DEPART_AT, PlannerOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "T1", solution.prev.transit.tripId)
+
+	solution, err = planner.explore(at, a, c, DEPART_AT, accessible)
+	assert.NoError(t, err)
+	assert.Equal(t, "T3", solution.prev.transit.tripId)
+
+	// the platforms of the station are only connected by stairs
+	dataset = testDataset("A", "P1", "P2", "C")
+	dataset.Stops = append(dataset.Stops, model.Stop{Id: "STATION", Type: "1", Wheelchair: model.Accessible})
+	dataset.Stops[1].Parent, dataset.Stops[2].Parent = "STATION", "STATION"
+	dataset.Pathways = []model.Pathway{{Id: "W1", FromStopId: "P1", ToStopId: "P2", Bidirectional: true, Mode: model.PathwayModeStairs}}
+	addTestTrip(dataset, model.Trip{Id: "T1", RouteId: "1", Wheelchair: model.Accessible}, stopAt("A", 8, 0), stopAt("P1", 8, 10))
+	addTestTrip(dataset, model.Trip{Id: "U1", RouteId: "2", Wheelchair: model.Accessible}, stopAt("P2", 8, 15), stopAt("C", 8, 25))
+	addTestTrip(dataset, model.Trip{Id: "T2", RouteId: "3", Wheelchair: model.Accessible}, stopAt("A", 9, 0), stopAt("C", 9, 30))
+
+	planner, scheduler := newTestTravel(dataset)
+	a, c = dataset.Stops[0].Location, dataset.Stops[3].Location
+
+	solution, err = planner.explore(at, a, c, DEPART_AT, PlannerOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "U1", solution.prev.transit.tripId)
+
+	solution, err = planner.explore(at, a, c, DEPART_AT, accessible)
+	assert.NoError(t, err)
+	assert.Equal(t, "T2", solution.prev.transit.tripId)
+
+	plan := &model.TravelPlan{
+		Origin:      a,
+		Destination: c,
+		Legs:        []model.TravelPlanLeg{{OriginId: "A", DestinationId: "P1", RouteId: "1"}, {OriginId: "P2", DestinationId: "C", RouteId: "2"}},
+	}
+
+	schedule, err := scheduler.Depart(at, plan, PlannerOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"T1", "U1"}, scheduledTrips(schedule))
+
+	_, err = scheduler.Depart(at, plan, accessible)
+	assert.Error(t, err)
+}
+
 func BenchmarkPlanner(b *testing.B) {
 	planner := newTestPlanner()
 	depart, _ := time.ParseInLocation("2006-01-02T15:04:00Z", "2022-12-30T12:55:00Z", time.Local)
@@ -34,7 +197,7 @@ func BenchmarkPlanner(b *testing.B) {
 
 	b.Run("benchmark", func(b *testing.B) {
 		for n := 0; n < b.N; n++ {
-			planner.Depart(depart, home, kanata2)
+			planner.Depart(depart, home, kanata2, PlannerOptions{})
 		}
 	})
 }
@@ -0,0 +1,209 @@
+package travel
+
+import (
+	"fmt"
+	"time"
+
+	"stop-checker.com/db/model"
+	"stop-checker.com/db/repository"
+)
+
+/* transferRule
+how riders can transfer from one transit leg to the next following the most specific transfer
+in transfers.txt, trips are more specific than routes and routes more specific than stops.
+used by both the planner and the scheduler
+*/
+type transferRule struct {
+	forbidden bool          // riders can't transfer between the legs
+	minTime   time.Duration // time required between arriving and departing
+}
+
+func getTransferRule(transfers repository.Transfers, from, to model.TransferPoint) transferRule {
+	transfer, err := transfers.Get(from, to)
+	if err != nil {
+		return transferRule{}
+	}
+
+	switch transfer.Type {
+	case model.TransferForbidden:
+		return transferRule{forbidden: true}
+	case model.TransferTimed:
+		// the departing vehicle waits for riders so no time is required
+		return transferRule{}
+	case model.TransferMinimumTime:
+		return transferRule{minTime: transfer.MinTime}
+	}
+	return transferRule{}
+}
+
+/* earliest
+time the next leg can depart when riders are ready at t and the previous leg arrived at arrival.
+in arrive by mode the direction is -1, t is the latest time and arrival is the departure of the next leg
+*/
+func (r transferRule) earliest(t, arrival time.Time, direction time.Duration) time.Time {
+	earliest := arrival.Add(direction * r.minTime)
+	if (direction > 0 && earliest.After(t)) || (direction < 0 && earliest.Before(t)) {
+		return earliest
+	}
+	return t
+}
+
+/* transferTime
+earliest time to board a route at the current node (latest time to get off in arrive by mode)
+following the transfer rules from the previous transit leg. false when the transfer is forbidden
+*/
+func (p *Planner) transferTime(current, previous *node, routeId string, mode Mode) (time.Time, bool) {
+	if previous == nil {
+		return current.time, true
+	}
+
+	// transfers are always from the trip that arrives first to the trip that departs after
+	previousPoint := model.TransferPoint{
+		StopId:  previous.id,
+		RouteId: previous.transit.routeId,
+		TripId:  previous.transit.tripId,
+	}
+	currentPoint := model.TransferPoint{
+		StopId:  current.id,
+		RouteId: routeId,
+	}
+
+	from, to, direction := previousPoint, currentPoint, time.Duration(1)
+	if mode == ARRIVE_BY {
+		from, to, direction = currentPoint, previousPoint, -1
+	}
+
+	rule := getTransferRule(p.transfers, from, to)
+	if rule.forbidden {
+		return time.Time{}, false
+	}
+	return rule.earliest(current.time, previous.time, direction), true
+}
+
+/* exploreInSeat
+continue on the same vehicle when the previous trip has an in-seat transfer to a trip of the route.
+the vehicle continues on the same service day so the times of the next trip are offset from the time
+the previous trip arrived. trips in frequencies.txt are searched for instead since it's not known which
+of the trips expanded from them continues the vehicle
+*/
+func (p *Planner) exploreInSeat(current, previous *node, stopRoute model.StopRoute, mode Mode, options PlannerOptions) []fastestTransit {
+	if previous == nil || previous != current {
+		return nil
+	}
+
+	previousStopTime, err := p.stopTime(previous.transit.tripId, current.ID())
+	if err != nil {
+		return nil
+	}
+
+	trips := options.trips()
+	results := []model.ReachableSchedule{}
+	frequencies := map[string]struct{}{}
+
+	for _, transfer := range p.transfers.InSeat(previous.transit.tripId, mode == ARRIVE_BY) {
+		tripId := transfer.To.TripId
+		if mode == ARRIVE_BY {
+			tripId = transfer.From.TripId
+		}
+
+		// only the trips expanded from trips in frequencies.txt are kept
+		trip, err := p.trips.Get(tripId)
+		if err != nil {
+			frequencies[tripId] = struct{}{}
+			continue
+		}
+
+		if trip.RouteId != stopRoute.RouteId || (trips != nil && !trips(trip)) {
+			continue
+		}
+		results = append(results, p.inSeat(current, previousStopTime, trip, mode)...)
+	}
+
+	if len(frequencies) > 0 {
+		// the next trip can leave at the same time the previous trip arrives
+		t := current.time.Add(-time.Second)
+		if mode == ARRIVE_BY {
+			t = current.time.Add(time.Second)
+		}
+
+		results = append(results, p.reach(current, stopRoute, mode, t, func(trip model.Trip) bool {
+			_, ok := frequencies[trip.GTFSId()]
+			return ok && (trips == nil || trips(trip))
+		})...)
+	}
+
+	reachable := toFastestTransit(usable(results, mode, options), mode)
+	for i := range reachable {
+		reachable[i].inSeat = true
+	}
+	return reachable
+}
+
+// stops reached by staying on the vehicle as it continues on the trip
+func (p *Planner) inSeat(current *node, previous model.StopTime, trip model.Trip, mode Mode) []model.ReachableSchedule {
+	stop, _ := p.stops.Get(current.ID())
+	stopTimes, _ := p.stopTimesByTrip.Get(trip.Id)
+	results := []model.ReachableSchedule{}
+
+	for i, stopTime := range stopTimes {
+		if stopTime.StopId != current.ID() {
+			continue
+		}
+
+		if mode == DEPART_AT {
+			// the trip leaves after the previous trip arrives
+			departure := current.time.Add(model.TimeDiff(previous.Arrival, stopTime.Departure))
+			if departure.Before(current.time) {
+				return nil
+			}
+
+			for _, next := range stopTimes[i+1:] {
+				destination, err := p.stops.Get(next.StopId)
+				if err != nil || !next.CanAlight() {
+					continue
+				}
+				results = append(results, model.ReachableSchedule{
+					Origin:      stop,
+					Destination: destination,
+					Departure:   departure,
+					Arrival:     departure.Add(model.TimeDiff(stopTime.Departure, next.Arrival)),
+					Trip:        trip,
+				})
+			}
+		} else {
+			// the trip arrives before the next trip leaves
+			arrival := current.time.Add(-model.TimeDiff(stopTime.Arrival, previous.Departure))
+			if arrival.After(current.time) {
+				return nil
+			}
+
+			for _, prev := range stopTimes[:i] {
+				origin, err := p.stops.Get(prev.StopId)
+				if err != nil || !prev.CanBoard() {
+					continue
+				}
+				results = append(results, model.ReachableSchedule{
+					Origin:      origin,
+					Destination: stop,
+					Departure:   arrival.Add(-model.TimeDiff(prev.Departure, stopTime.Arrival)),
+					Arrival:     arrival,
+					Trip:        trip,
+				})
+			}
+		}
+		return results
+	}
+
+	return results
+}
+
+// stop time of a trip at a stop
+func (p *Planner) stopTime(tripId, stopId string) (model.StopTime, error) {
+	stopTimes, _ := p.stopTimesByTrip.Get(tripId)
+	for _, stopTime := range stopTimes {
+		if stopTime.StopId == stopId {
+			return stopTime, nil
+		}
+	}
+	return model.StopTime{}, fmt.Errorf("trip %s doesn't visit stop %s", tripId, stopId)
+}
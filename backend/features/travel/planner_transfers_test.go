@@ -0,0 +1,90 @@
+package travel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+func TestPlannerInSeat(t *testing.T) {
+	// T1 continues as U1 at B but U0 leaves B first and T0 arrives at B last
+	dataset := testDataset("A", "B", "C")
+	addTestTrip(dataset, model.Trip{Id: "T1", RouteId: "1"}, stopAt("A", 8, 0), stopAt("B", 8, 10))
+	addTestTrip(dataset, model.Trip{Id: "T0", RouteId: "1"}, stopAt("A", 8, 2), stopAt("B", 8, 12))
+	addTestTrip(dataset, model.Trip{Id: "U0", RouteId: "2"}, stopAt("B", 8, 12), stopAt("C", 8, 22))
+	addTestTrip(dataset, model.Trip{Id: "U1", RouteId: "2"}, stopAt("B", 8, 15), stopAt("C", 8, 25))
+
+	// getting off to transfer at B takes 30 minutes
+	dataset.Transfers = []model.Transfer{
+		{From: model.TransferPoint{StopId: "B", RouteId: "1"}, To: model.TransferPoint{StopId: "B", RouteId: "2"}, Type: model.TransferMinimumTime, MinTime: 30 * time.Minute},
+		{From: model.TransferPoint{TripId: "T1"}, To: model.TransferPoint{TripId: "U1"}, Type: model.TransferInSeat},
+	}
+
+	planner, _ := newTestTravel(dataset)
+	a, c := dataset.Stops[0].Location, dataset.Stops[2].Location
+
+	solution, err := planner.explore(testDay.Add(7*time.Hour+55*time.Minute), a, c, DEPART_AT, PlannerOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, testDay.Add(8*time.Hour+26*time.Minute), solution.time)
+	assert.Equal(t, "U1", solution.prev.transit.tripId)
+	assert.Equal(t, "T1", solution.prev.prev.transit.tripId)
+
+	solution, err = planner.explore(testDay.Add(8*time.Hour+30*time.Minute), c, a, ARRIVE_BY, PlannerOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, testDay.Add(7*time.Hour+59*time.Minute), solution.time)
+	assert.Equal(t, "T1", solution.prev.transit.tripId)
+	assert.Equal(t, "U1", solution.prev.prev.transit.tripId)
+}
+
+func TestSchedulerTransferRules(t *testing.T) {
+	dataset := testDataset("A", "B", "C")
+	addTestTrip(dataset, model.Trip{Id: "T1", RouteId: "1"}, stopAt("A", 8, 0), stopAt("B", 8, 10))
+	addTestTrip(dataset, model.Trip{Id: "U0", RouteId: "2"}, stopAt("B", 8, 12), stopAt("C", 8, 22))
+	addTestTrip(dataset, model.Trip{Id: "U1", RouteId: "2"}, stopAt("B", 8, 20), stopAt("C", 8, 30))
+	addTestTrip(dataset, model.Trip{Id: "U2", RouteId: "2"}, stopAt("B", 8, 45), stopAt("C", 8, 55))
+
+	plan := &model.TravelPlan{
+		Origin:      dataset.Stops[0].Location,
+		Destination: dataset.Stops[2].Location,
+		Legs:        []model.TravelPlanLeg{{OriginId: "A", DestinationId: "B", RouteId: "1"}, {OriginId: "B", DestinationId: "C", RouteId: "2"}},
+	}
+
+	routeMinimum := model.Transfer{From: model.TransferPoint{StopId: "B", RouteId: "1"}, To: model.TransferPoint{StopId: "B", RouteId: "2"}, Type: model.TransferMinimumTime, MinTime: 30 * time.Minute}
+	routeForbidden := model.Transfer{From: model.TransferPoint{StopId: "B", RouteId: "1"}, To: model.TransferPoint{StopId: "B", RouteId: "2"}, Type: model.TransferForbidden}
+	tripTimed := model.Transfer{From: model.TransferPoint{StopId: "B", TripId: "T1"}, To: model.TransferPoint{StopId: "B", RouteId: "2"}, Type: model.TransferTimed}
+	tripForbidden := model.Transfer{From: model.TransferPoint{StopId: "B", RouteId: "1"}, To: model.TransferPoint{StopId: "B", TripId: "U0"}, Type: model.TransferForbidden}
+
+	at := testDay.Add(7*time.Hour + 55*time.Minute)
+	by := testDay.Add(9 * time.Hour)
+
+	for _, test := range []struct {
+		name      string
+		transfers []model.Transfer
+		trips     []string
+	}{
+		{"no transfer rules", nil, []string{"T1", "U0"}},
+		{"minimum time", []model.Transfer{routeMinimum}, []string{"T1", "U2"}},
+		{"trips are more specific than routes", []model.Transfer{routeMinimum, tripTimed}, []string{"T1", "U0"}},
+		{"forbidden to a trip", []model.Transfer{tripForbidden}, []string{"T1", "U1"}},
+		{"forbidden", []model.Transfer{routeForbidden}, nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			dataset.Transfers = test.transfers
+			_, scheduler := newTestTravel(dataset)
+
+			depart, err := scheduler.Depart(at, plan, PlannerOptions{})
+			if test.trips == nil {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.trips, scheduledTrips(depart))
+
+			arrive, err := scheduler.Arrive(by, plan, PlannerOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, test.trips, scheduledTrips(arrive))
+		})
+	}
+}
@@ -15,25 +15,31 @@ func NewScheduler(
 	directions walkingDirections,
 	directionsCache walkingDirectionsCache,
 	stopIndex repository.Stops,
+	transfers repository.Transfers,
+	pathways repository.Pathways,
 	reachIndex repository.ReachableBetween,
 	stopTimesByTrip repository.InvertedIndex[model.StopTime],
+	predictions realtimePredictions,
 ) *Scheduler {
 	return &Scheduler{
 		edgeFactory: &edgeFactory{
 			directions:      directions,
 			directionsCache: directionsCache,
 			stops:           stopIndex,
+			transfers:       transfers,
+			pathways:        pathways,
 			reach: &scheduleReachImpl{
 				reachIndex:      reachIndex,
 				stopTimesByTrip: stopTimesByTrip,
 				stopIndex:       stopIndex,
+				realtime:        newRealtime(predictions, stopTimesByTrip),
 			},
 		},
 	}
 }
 
-func (s *Scheduler) Depart(at time.Time, plan *model.TravelPlan) (*model.TravelSchedule, error) {
-	edges, err := s.Edges(plan)
+func (s *Scheduler) Depart(at time.Time, plan *model.TravelPlan, options PlannerOptions) (*model.TravelSchedule, error) {
+	edges, err := s.Edges(plan, options)
 	if err != nil {
 		return nil, err
 	}
@@ -55,8 +61,8 @@ func (s *Scheduler) Depart(at time.Time, plan *model.TravelPlan) (*model.TravelS
 	return s.depart(edges, optimized.OriginDeparture)
 }
 
-func (s *Scheduler) Arrive(by time.Time, plan *model.TravelPlan) (*model.TravelSchedule, error) {
-	edges, err := s.Edges(plan)
+func (s *Scheduler) Arrive(by time.Time, plan *model.TravelPlan, options PlannerOptions) (*model.TravelSchedule, error) {
+	edges, err := s.Edges(plan, options)
 	if err != nil {
 		return nil, err
 	}
@@ -73,14 +79,19 @@ func (s *Scheduler) Arrive(by time.Time, plan *model.TravelPlan) (*model.TravelS
 func (s *Scheduler) depart(edges []scheduleEdge, at time.Time) (*model.TravelSchedule, error) {
 	acc := at
 	legs := []model.TravelScheduleLeg{}
+	var transfer *model.TravelScheduleLeg // previous transit leg
 
 	for _, edge := range edges {
-		leg, err := edge.Depart(acc)
+		leg, err := edge.Depart(acc, transfer)
 		if err != nil {
 			return nil, err
 		}
 		legs = append(legs, leg)
 		acc = leg.Destination.Arrival
+
+		if leg.Transit != nil {
+			transfer = &leg
+		}
 	}
 
 	return &model.TravelSchedule{
@@ -93,14 +104,19 @@ func (s *Scheduler) depart(edges []scheduleEdge, at time.Time) (*model.TravelSch
 func (s *Scheduler) arrive(edges []scheduleEdge, by time.Time) (*model.TravelSchedule, error) {
 	acc := by
 	legs := []model.TravelScheduleLeg{}
+	var transfer *model.TravelScheduleLeg // next transit leg
 
 	for i := len(edges) - 1; i >= 0; i-- {
-		leg, err := edges[i].Arrive(acc)
+		leg, err := edges[i].Arrive(acc, transfer)
 		if err != nil {
 			return nil, err
 		}
 		legs = append(legs, leg)
 		acc = leg.Origin.Arrival
+
+		if leg.Transit != nil {
+			transfer = &leg
+		}
 	}
 
 	for i, j := 0, len(legs)-1; i < j; i, j = i+1, j-1 {
@@ -1,6 +1,7 @@
 package travel
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/rs/zerolog/log"
@@ -12,10 +13,12 @@ type edgeFactory struct {
 	directions      walkingDirections
 	directionsCache walkingDirectionsCache
 	stops           repository.Stops
+	transfers       repository.Transfers
+	pathways        repository.Pathways
 	reach           scheduleReach
 }
 
-func (f *edgeFactory) Edges(plan *model.TravelPlan) ([]scheduleEdge, error) {
+func (f *edgeFactory) Edges(plan *model.TravelPlan, options PlannerOptions) ([]scheduleEdge, error) {
 	edges := []scheduleEdge{}
 
 	current := &scheduleNode{
@@ -24,41 +27,79 @@ func (f *edgeFactory) Edges(plan *model.TravelPlan) ([]scheduleEdge, error) {
 	}
 
 	for _, leg := range plan.Legs {
-		originNode, destinationNode, err := f.getNodes(leg)
+		originNode, destinationNode, err := f.getNodes(leg, options)
 		if err != nil {
 			return nil, err
 		}
 
 		if current.Id != originNode.Id {
 			// add a walking edge from current to origin node
-			edges = append(edges, f.getWalkingDirectionsEdge(current, originNode))
+			walk, err := f.getWalkingDirectionsEdge(current, originNode, options)
+			if err != nil {
+				return nil, err
+			}
+			edges = append(edges, walk)
 		}
 
 		edges = append(edges, &scheduleTransitEdge{
-			edge:    &edge{origin: originNode, destination: destinationNode},
-			routeId: leg.RouteId,
-			reach:   f.reach,
+			edge:      &edge{origin: originNode, destination: destinationNode},
+			routeId:   leg.RouteId,
+			transfers: f.transfers,
+			trips:     options.trips(),
+			realtime:  options.Realtime,
+			reach:     f.reach,
 		})
 
 		current = destinationNode
 	}
 
-	edges = append(edges, f.getWalkingDirectionsEdge(current, &scheduleNode{
+	walk, err := f.getWalkingDirectionsEdge(current, &scheduleNode{
 		Id:       "#DESTINATION",
 		Location: plan.Destination,
-	}))
+	}, options)
+	if err != nil {
+		return nil, err
+	}
 
-	return edges, nil
+	return append(edges, walk), nil
 }
 
-func (f *edgeFactory) getWalkingDirectionsEdge(origin, destination *scheduleNode) *scheduleWalkEdge {
+func (f *edgeFactory) getWalkingDirectionsEdge(origin, destination *scheduleNode, options PlannerOptions) (*scheduleWalkEdge, error) {
+	// walking between platforms of a station using its pathways
+	if f.sameStation(origin, destination) {
+		duration, err := f.pathways.Duration(origin.Id, destination.Id, options.Accessible)
+		if errors.Is(err, model.ErrInaccessiblePathway) {
+			return nil, fmt.Errorf("stop %s can't be reached from %s without stairs or escalators", destination.Id, origin.Id)
+		}
+		if err == nil {
+			return &scheduleWalkEdge{
+				edge: &edge{origin: origin, destination: destination},
+				path: &model.Path{
+					Distance: model.Distance(origin.Location, destination.Location),
+					Path:     []model.Location{origin.Location, destination.Location},
+				},
+				duration: duration,
+			}, nil
+		}
+	}
+
 	path := f.getDirections(origin, destination)
 
 	return &scheduleWalkEdge{
 		edge:     &edge{origin: origin, destination: destination},
 		path:     &path,
 		duration: walkingDuration(path.Distance),
+	}, nil
+}
+
+func (f *edgeFactory) sameStation(origin, destination *scheduleNode) bool {
+	originStop, err := f.stops.Get(origin.Id)
+	if err != nil || originStop.Parent == "" {
+		return false
 	}
+
+	destinationStop, err := f.stops.Get(destination.Id)
+	return err == nil && originStop.Parent == destinationStop.Parent
 }
 
 func (f *edgeFactory) getDirections(origin, destination *scheduleNode) model.Path {
@@ -84,13 +125,17 @@ func (f *edgeFactory) getDirections(origin, destination *scheduleNode) model.Pat
 }
 
 // return the origin and destination nodes for a model.TravelPlanLeg
-func (f *edgeFactory) getNodes(leg model.TravelPlanLeg) (*scheduleNode, *scheduleNode, error) {
+func (f *edgeFactory) getNodes(leg model.TravelPlanLeg, options PlannerOptions) (*scheduleNode, *scheduleNode, error) {
 	// get origin stop
 	origin, err := f.stops.Get(leg.OriginId)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if !options.uses(origin) {
+		return nil, nil, fmt.Errorf("stop %s is not wheelchair accessible", origin.Id)
+	}
+
 	originNode := &scheduleNode{
 		Id:       leg.OriginId,
 		Location: origin.Location,
@@ -102,6 +147,10 @@ func (f *edgeFactory) getNodes(leg model.TravelPlanLeg) (*scheduleNode, *schedul
 		return nil, nil, err
 	}
 
+	if !options.uses(destination) {
+		return nil, nil, fmt.Errorf("stop %s is not wheelchair accessible", destination.Id)
+	}
+
 	destinationNode := &scheduleNode{
 		Id:       leg.DestinationId,
 		Location: destination.Location,
@@ -1,9 +1,11 @@
 package travel
 
 import (
+	"fmt"
 	"time"
 
 	"stop-checker.com/db/model"
+	"stop-checker.com/db/repository"
 )
 
 type scheduleNode struct {
@@ -19,11 +21,13 @@ func (e *edge) Edge() *edge {
 	return e
 }
 
-/* scheduleEdge core interface */
+/* scheduleEdge core interface
+transfer is the closest transit leg scheduled before the edge (after the edge when arriving), nil when there is none
+*/
 type scheduleEdge interface {
-	Edge() *edge                                          // get the origin and destination information of the end
-	Depart(at time.Time) (model.TravelScheduleLeg, error) // create a model leg by departing from the origin at a specific time
-	Arrive(by time.Time) (model.TravelScheduleLeg, error) // create a model leg arriving to the destination by a specific time
+	Edge() *edge                                                                             // get the origin and destination information of the end
+	Depart(at time.Time, transfer *model.TravelScheduleLeg) (model.TravelScheduleLeg, error) // create a model leg by departing from the origin at a specific time
+	Arrive(by time.Time, transfer *model.TravelScheduleLeg) (model.TravelScheduleLeg, error) // create a model leg arriving to the destination by a specific time
 }
 
 type scheduleWalkEdge struct {
@@ -32,7 +36,7 @@ type scheduleWalkEdge struct {
 	duration time.Duration
 }
 
-func (s *scheduleWalkEdge) Depart(at time.Time) (model.TravelScheduleLeg, error) {
+func (s *scheduleWalkEdge) Depart(at time.Time, _ *model.TravelScheduleLeg) (model.TravelScheduleLeg, error) {
 	return model.TravelScheduleLeg{
 		Origin: model.TravelScheduleNode{
 			Id:       s.origin.Id,
@@ -50,7 +54,7 @@ func (s *scheduleWalkEdge) Depart(at time.Time) (model.TravelScheduleLeg, error)
 }
 
 // Arrive at the destination of the edge by a certain time
-func (s *scheduleWalkEdge) Arrive(by time.Time) (model.TravelScheduleLeg, error) {
+func (s *scheduleWalkEdge) Arrive(by time.Time, _ *model.TravelScheduleLeg) (model.TravelScheduleLeg, error) {
 	return model.TravelScheduleLeg{
 		Origin: model.TravelScheduleNode{
 			Id:       s.origin.Id,
@@ -69,12 +73,15 @@ func (s *scheduleWalkEdge) Arrive(by time.Time) (model.TravelScheduleLeg, error)
 
 type scheduleTransitEdge struct {
 	*edge
-	routeId string
-	reach   scheduleReach
+	routeId   string
+	transfers repository.Transfers
+	trips     model.TripFilter
+	realtime  bool // use predicted times
+	reach     scheduleReach
 }
 
-func (s *scheduleTransitEdge) Depart(at time.Time) (model.TravelScheduleLeg, error) {
-	res, err := s.reach.Depart(s.origin.Id, s.destination.Id, s.routeId, at)
+func (s *scheduleTransitEdge) Depart(at time.Time, previous *model.TravelScheduleLeg) (model.TravelScheduleLeg, error) {
+	res, err := s.depart(at, previous)
 	if err != nil {
 		return model.TravelScheduleLeg{}, err
 	}
@@ -101,8 +108,8 @@ func (s *scheduleTransitEdge) Depart(at time.Time) (model.TravelScheduleLeg, err
 	}, nil
 }
 
-func (s *scheduleTransitEdge) Arrive(by time.Time) (model.TravelScheduleLeg, error) {
-	res, err := s.reach.Arrive(s.origin.Id, s.destination.Id, s.routeId, by)
+func (s *scheduleTransitEdge) Arrive(by time.Time, next *model.TravelScheduleLeg) (model.TravelScheduleLeg, error) {
+	res, err := s.arrive(by, next)
 	if err != nil {
 		return model.TravelScheduleLeg{}, err
 	}
@@ -128,3 +135,73 @@ func (s *scheduleTransitEdge) Arrive(by time.Time) (model.TravelScheduleLeg, err
 		Walk: nil,
 	}, nil
 }
+
+/* depart
+next trip from the origin that can be transferred to from the previous transit leg.
+transfer rules can be specific to the trip so trips are tried until one is allowed
+*/
+func (s *scheduleTransitEdge) depart(at time.Time, previous *model.TravelScheduleLeg) (*scheduleReachResult, error) {
+	if previous == nil {
+		return s.reach.Depart(s.origin.Id, s.destination.Id, s.routeId, at, s.trips, s.realtime)
+	}
+
+	after := at
+	for i := 0; i < TRANSFER_CANDIDATES; i++ {
+		res, err := s.reach.Depart(s.origin.Id, s.destination.Id, s.routeId, after, s.trips, s.realtime)
+		if err != nil {
+			return nil, err
+		}
+
+		rule := getTransferRule(s.transfers,
+			model.TransferPoint{StopId: previous.Destination.Id, RouteId: previous.Transit.RouteId, TripId: previous.Transit.TripId},
+			model.TransferPoint{StopId: s.origin.Id, RouteId: s.routeId, TripId: res.tripId},
+		)
+
+		earliest := rule.earliest(at, previous.Destination.Arrival, 1)
+		if !rule.forbidden && !res.originDeparture.Before(earliest) {
+			return res, nil
+		}
+
+		after = res.originDeparture.Add(time.Second)
+		if !rule.forbidden && earliest.After(after) {
+			after = earliest
+		}
+	}
+
+	return nil, fmt.Errorf("no trip of route %s can be transferred to at stop %s", s.routeId, s.origin.Id)
+}
+
+/* arrive
+previous trip to the destination that can be transferred from to the next transit leg.
+transfer rules can be specific to the trip so trips are tried until one is allowed
+*/
+func (s *scheduleTransitEdge) arrive(by time.Time, next *model.TravelScheduleLeg) (*scheduleReachResult, error) {
+	if next == nil {
+		return s.reach.Arrive(s.origin.Id, s.destination.Id, s.routeId, by, s.trips, s.realtime)
+	}
+
+	before := by
+	for i := 0; i < TRANSFER_CANDIDATES; i++ {
+		res, err := s.reach.Arrive(s.origin.Id, s.destination.Id, s.routeId, before, s.trips, s.realtime)
+		if err != nil {
+			return nil, err
+		}
+
+		rule := getTransferRule(s.transfers,
+			model.TransferPoint{StopId: s.destination.Id, RouteId: s.routeId, TripId: res.tripId},
+			model.TransferPoint{StopId: next.Origin.Id, RouteId: next.Transit.RouteId, TripId: next.Transit.TripId},
+		)
+
+		latest := rule.earliest(by, next.Transit.OriginDeparture, -1)
+		if !rule.forbidden && !res.destinationArrival.After(latest) {
+			return res, nil
+		}
+
+		before = res.destinationArrival.Add(-time.Second)
+		if !rule.forbidden && latest.Before(before) {
+			before = latest
+		}
+	}
+
+	return nil, fmt.Errorf("no trip of route %s can be transferred from at stop %s", s.routeId, s.destination.Id)
+}
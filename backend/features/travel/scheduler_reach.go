@@ -1,6 +1,7 @@
 package travel
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -16,20 +17,28 @@ type scheduleReachResult struct {
 
 type scheduleReach interface {
 	// Depart from the origin at a certain time
-	Depart(originId, destinationId, routeId string, at time.Time) (*scheduleReachResult, error)
+	Depart(originId, destinationId, routeId string, at time.Time, trips model.TripFilter, realtime bool) (*scheduleReachResult, error)
 	// Arrive to the destination by a certain time
-	Arrive(originId, destinationId, routeId string, by time.Time) (*scheduleReachResult, error)
+	Arrive(originId, destinationId, routeId string, by time.Time, trips model.TripFilter, realtime bool) (*scheduleReachResult, error)
 }
 
 type scheduleReachImpl struct {
 	reachIndex      repository.ReachableBetween
 	stopTimesByTrip repository.InvertedIndex[model.StopTime]
 	stopIndex       repository.Stops
+	realtime        *realtime
 }
 
 // Depart from the origin at a certain time
-func (s *scheduleReachImpl) Depart(originId, destinationId, routeId string, at time.Time) (*scheduleReachResult, error) {
-	originSchedule, _ := s.reachIndex.ReachableBetweenWithSchedule(originId, destinationId, routeId)
+func (s *scheduleReachImpl) Depart(originId, destinationId, routeId string, at time.Time, trips model.TripFilter, realtime bool) (*scheduleReachResult, error) {
+	originSchedule, _ := s.reachIndex.ReachableBetweenWithSchedule(originId, destinationId, routeId, trips)
+
+	if realtime && s.realtime.near(at) {
+		// trips after the time so frequent routes always have candidates, then delayed trips scheduled earlier
+		candidates := originSchedule.After(at, REALTIME_CANDIDATES)
+		candidates = append(candidates, originSchedule.After(at.Add(-REALTIME_LOOKBACK), REALTIME_CANDIDATES)...)
+		return s.departRealtime(originId, destinationId, candidates, at)
+	}
 
 	// planned leg by transit
 	next, err := originSchedule.Next(at)
@@ -41,18 +50,19 @@ func (s *scheduleReachImpl) Depart(originId, destinationId, routeId string, at t
 	all, _ := s.stopTimesByTrip.Get(next.TripId)
 
 	// origin stop time
-	originArrival, err := s.stoptime(originId, all)
+	originStopTime, err := s.stoptime(originId, all)
 	if err != nil {
 		return nil, err
 	}
 
 	// destination stop time
-	destinationArrival, err := s.stoptime(destinationId, all)
+	destinationStopTime, err := s.stoptime(destinationId, all)
 	if err != nil {
 		return nil, err
 	}
 
-	duration := model.TimeDiff(originArrival.Time, destinationArrival.Time)
+	// board at the origin departure, alight at the destination arrival
+	duration := model.TimeDiff(originStopTime.Departure, destinationStopTime.Arrival)
 
 	return &scheduleReachResult{
 		tripId:             next.TripId,
@@ -62,8 +72,14 @@ func (s *scheduleReachImpl) Depart(originId, destinationId, routeId string, at t
 }
 
 // Arrive to the destination by a certain time
-func (s *scheduleReachImpl) Arrive(originId, destinationId, routeId string, by time.Time) (*scheduleReachResult, error) {
-	_, destinationSchedule := s.reachIndex.ReachableBetweenWithSchedule(originId, destinationId, routeId)
+func (s *scheduleReachImpl) Arrive(originId, destinationId, routeId string, by time.Time, trips model.TripFilter, realtime bool) (*scheduleReachResult, error) {
+	_, destinationSchedule := s.reachIndex.ReachableBetweenWithSchedule(originId, destinationId, routeId, trips)
+
+	if realtime && s.realtime.near(by) {
+		candidates := destinationSchedule.Before(by, REALTIME_CANDIDATES)
+		candidates = append(candidates, destinationSchedule.Before(by.Add(REALTIME_LOOKBACK), REALTIME_CANDIDATES)...)
+		return s.arriveRealtime(originId, destinationId, candidates, by)
+	}
 
 	// planned leg by transit
 	previous, err := destinationSchedule.Previous(by)
@@ -75,18 +91,19 @@ func (s *scheduleReachImpl) Arrive(originId, destinationId, routeId string, by t
 	all, _ := s.stopTimesByTrip.Get(previous.TripId)
 
 	// origin stop time
-	originArrival, err := s.stoptime(originId, all)
+	originStopTime, err := s.stoptime(originId, all)
 	if err != nil {
 		return nil, err
 	}
 
 	// destination stop time
-	destinationArrival, err := s.stoptime(destinationId, all)
+	destinationStopTime, err := s.stoptime(destinationId, all)
 	if err != nil {
 		return nil, err
 	}
 
-	duration := model.TimeDiff(originArrival.Time, destinationArrival.Time)
+	// board at the origin departure, alight at the destination arrival
+	duration := model.TimeDiff(originStopTime.Departure, destinationStopTime.Arrival)
 
 	return &scheduleReachResult{
 		tripId:             previous.TripId,
@@ -95,6 +112,85 @@ func (s *scheduleReachImpl) Arrive(originId, destinationId, routeId string, by t
 	}, nil
 }
 
+// trip with the earliest predicted arrival that departs the origin at or after a certain time
+func (s *scheduleReachImpl) departRealtime(originId, destinationId string, candidates []model.ScheduleResult, at time.Time) (*scheduleReachResult, error) {
+	var best *scheduleReachResult
+	search := s.realtime.search()
+
+	for _, candidate := range candidates {
+		duration, err := s.duration(originId, destinationId, candidate.TripId)
+		if err != nil {
+			continue
+		}
+
+		leg := search.predict(candidate.TripId, originId, destinationId, candidate.Time, candidate.Time.Add(duration))
+		if !leg.board || !leg.alight || leg.departure.Before(at) {
+			continue
+		}
+
+		if best == nil || leg.arrival.Before(best.destinationArrival) {
+			best = &scheduleReachResult{
+				tripId:             candidate.TripId,
+				originDeparture:    leg.departure,
+				destinationArrival: leg.arrival,
+			}
+		}
+	}
+
+	if best == nil {
+		return nil, errors.New("not found")
+	}
+	return best, nil
+}
+
+// trip with the latest predicted departure that arrives at the destination by a certain time
+func (s *scheduleReachImpl) arriveRealtime(originId, destinationId string, candidates []model.ScheduleResult, by time.Time) (*scheduleReachResult, error) {
+	var best *scheduleReachResult
+	search := s.realtime.search()
+
+	for _, candidate := range candidates {
+		duration, err := s.duration(originId, destinationId, candidate.TripId)
+		if err != nil {
+			continue
+		}
+
+		leg := search.predict(candidate.TripId, originId, destinationId, candidate.Time.Add(-duration), candidate.Time)
+		if !leg.board || !leg.alight || leg.arrival.After(by) {
+			continue
+		}
+
+		if best == nil || leg.departure.After(best.originDeparture) {
+			best = &scheduleReachResult{
+				tripId:             candidate.TripId,
+				originDeparture:    leg.departure,
+				destinationArrival: leg.arrival,
+			}
+		}
+	}
+
+	if best == nil {
+		return nil, errors.New("not found")
+	}
+	return best, nil
+}
+
+// scheduled duration of a trip from boarding at the origin to alighting at the destination
+func (s *scheduleReachImpl) duration(originId, destinationId, tripId string) (time.Duration, error) {
+	all, _ := s.stopTimesByTrip.Get(tripId)
+
+	originStopTime, err := s.stoptime(originId, all)
+	if err != nil {
+		return 0, err
+	}
+
+	destinationStopTime, err := s.stoptime(destinationId, all)
+	if err != nil {
+		return 0, err
+	}
+
+	return model.TimeDiff(originStopTime.Departure, destinationStopTime.Arrival), nil
+}
+
 // helper to get stop time from a trip
 func (s *scheduleReachImpl) stoptime(stopId string, all []model.StopTime) (model.StopTime, error) {
 	for _, stopTime := range all {
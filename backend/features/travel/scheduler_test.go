@@ -0,0 +1,57 @@
+package travel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"stop-checker.com/db/model"
+)
+
+func TestSchedulerArrivalDeparture(t *testing.T) {
+	// X waits at B so it arrives at B before Y but departs B after Y
+	dataset := testDataset("A", "B", "C")
+	waitAtB := stopAt("B", 8, 0)
+	waitAtB.Departure = model.NewTime(8, 20, 0)
+	addTestTrip(dataset, model.Trip{Id: "X", RouteId: "1"}, stopAt("A", 7, 50), waitAtB, stopAt("C", 8, 30))
+
+	dwellAtB := stopAt("B", 8, 10)
+	dwellAtB.Departure = model.NewTime(8, 11, 0)
+	addTestTrip(dataset, model.Trip{Id: "Y", RouteId: "1"}, stopAt("A", 8, 0), dwellAtB, stopAt("C", 8, 21))
+
+	_, scheduler := newTestTravel(dataset)
+	at := func(hour, minute int) time.Time {
+		return testDay.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+
+	toB := &model.TravelPlan{
+		Origin:      dataset.Stops[0].Location,
+		Destination: dataset.Stops[1].Location,
+		Legs:        []model.TravelPlanLeg{{OriginId: "A", DestinationId: "B", RouteId: "1"}},
+	}
+
+	// the transit leg arrives at B at the arrival time of the trip
+	schedule, err := scheduler.Arrive(at(8, 16), toB, PlannerOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Y"}, scheduledTrips(schedule))
+	assert.Equal(t, at(8, 0), schedule.Legs[1].Transit.OriginDeparture)
+	assert.Equal(t, at(8, 10), schedule.Legs[1].Destination.Arrival)
+
+	schedule, err = scheduler.Depart(at(7, 45), toB, PlannerOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"X"}, scheduledTrips(schedule))
+	assert.Equal(t, at(8, 0), schedule.Legs[1].Destination.Arrival)
+
+	fromB := &model.TravelPlan{
+		Origin:      dataset.Stops[1].Location,
+		Destination: dataset.Stops[2].Location,
+		Legs:        []model.TravelPlanLeg{{OriginId: "B", DestinationId: "C", RouteId: "1"}},
+	}
+
+	// the transit leg leaves B at the departure time of the trip
+	schedule, err = scheduler.Depart(at(8, 5), fromB, PlannerOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Y"}, scheduledTrips(schedule))
+	assert.Equal(t, at(8, 11), schedule.Legs[1].Transit.OriginDeparture)
+	assert.Equal(t, at(8, 21), schedule.Legs[1].Destination.Arrival)
+}
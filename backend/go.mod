@@ -2,13 +2,9 @@ module stop-checker.com
 
 go 1.18
 
-require github.com/artonge/go-csv-tag/v2 v2.0.7
-
 require (
 	github.com/agnivade/levenshtein v1.1.1 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/fsnotify/fsnotify v1.6.0 // indirect
-	github.com/gorilla/websocket v1.5.0 // indirect
 	github.com/hashicorp/golang-lru v0.5.4 // indirect
 	github.com/hashicorp/hcl v1.0.0 // indirect
 	github.com/magiconair/properties v1.8.6 // indirect
@@ -31,7 +27,10 @@ require (
 
 require (
 	github.com/99designs/gqlgen v0.17.22
+	github.com/MobilityData/gtfs-realtime-bindings/golang/gtfs v1.0.0
+	github.com/fsnotify/fsnotify v1.6.0
 	github.com/go-chi/chi/v5 v5.0.8
+	github.com/gorilla/websocket v1.5.0
 	github.com/rs/cors v1.8.3
 	github.com/rs/zerolog v1.28.0
 	github.com/spf13/pflag v1.0.5
@@ -39,4 +38,5 @@ require (
 	github.com/stretchr/testify v1.8.1
 	github.com/uber/h3-go v3.0.1+incompatible
 	github.com/vektah/gqlparser/v2 v2.5.1
+	google.golang.org/protobuf v1.28.1
 )